@@ -0,0 +1,55 @@
+// devtoken は開発モードの BFF が受け付ける開発用トークンを作成します。
+//
+//	go run ./cmd/devtoken -uid dev-user -roles admin,developer
+//
+// 署名鍵は DEV_AUTH_SECRET（.env も参照）から読み込みます。
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/joho/godotenv"
+
+	"github.com/fun-dotto/admin-bff-api/internal/middleware"
+)
+
+func main() {
+	uid := flag.String("uid", "dev-user", "トークンの UID (sub)")
+	roles := flag.String("roles", "admin", "true にするロールのカスタムクレーム（カンマ区切り）")
+	extraClaims := flag.String("claims", "", "追加するクレームの JSON オブジェクト")
+	ttl := flag.Duration("ttl", 0, "有効期間。0 の場合は有効期限なし")
+	flag.Parse()
+
+	_ = godotenv.Load()
+	secret := os.Getenv("DEV_AUTH_SECRET")
+	if secret == "" {
+		exit(fmt.Errorf("DEV_AUTH_SECRET is required"))
+	}
+
+	claims := map[string]any{}
+	if *extraClaims != "" {
+		if err := json.Unmarshal([]byte(*extraClaims), &claims); err != nil {
+			exit(fmt.Errorf("invalid -claims: %w", err))
+		}
+	}
+	for role := range strings.SplitSeq(*roles, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			claims[role] = true
+		}
+	}
+
+	token, err := middleware.SignDevToken([]byte(secret), *uid, claims, *ttl)
+	if err != nil {
+		exit(err)
+	}
+	fmt.Println(token)
+}
+
+func exit(err error) {
+	fmt.Fprintln(os.Stderr, "devtoken:", err)
+	os.Exit(1)
+}
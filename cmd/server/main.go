@@ -2,68 +2,237 @@ package main
 
 import (
 	"context"
-	"log"
+	"errors"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	firebase "firebase.google.com/go/v4"
 	api "github.com/fun-dotto/admin-bff-api/generated"
+	"github.com/fun-dotto/admin-bff-api/internal/apidocs"
+	"github.com/fun-dotto/admin-bff-api/internal/audit"
+	"github.com/fun-dotto/admin-bff-api/internal/cache"
+	"github.com/fun-dotto/admin-bff-api/internal/config"
 	"github.com/fun-dotto/admin-bff-api/internal/handler"
+	"github.com/fun-dotto/admin-bff-api/internal/health"
 	"github.com/fun-dotto/admin-bff-api/internal/infrastructure"
+	"github.com/fun-dotto/admin-bff-api/internal/logging"
+	"github.com/fun-dotto/admin-bff-api/internal/metrics"
 	"github.com/fun-dotto/admin-bff-api/internal/middleware"
+	"github.com/fun-dotto/admin-bff-api/internal/notificationtemplate"
+	"github.com/fun-dotto/admin-bff-api/internal/server"
+	"github.com/fun-dotto/admin-bff-api/internal/telemetry"
+	"github.com/fun-dotto/admin-bff-api/openapi"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
-	"github.com/oapi-codegen/gin-middleware"
 )
 
+// auditLogCapacity /v1/auditLogs で参照できるように保持する監査ログの件数
+const auditLogCapacity = 1000
+
+// readinessTimeout /readyz で依存先 1 件の確認を打ち切るまでの時間
+const readinessTimeout = 3 * time.Second
+
 func main() {
-	if err := godotenv.Load(); err != nil {
-		log.Printf("Warning: .env file not found: %v", err)
+	envErr := godotenv.Load()
+
+	cfg, cfgErr := config.Load()
+	level := slog.LevelInfo
+	if cfgErr == nil {
+		level = cfg.LogLevel
+	}
+	logger := logging.NewLogger(os.Stdout, level)
+	slog.SetDefault(logger)
+	if envErr != nil {
+		logger.Warn(".env file not found", slog.Any("error", envErr))
+	}
+	if cfgErr != nil {
+		fatal("Failed to load configuration", cfgErr)
 	}
 
 	ctx := context.Background()
-	app, err := firebase.NewApp(ctx, nil)
+
+	shutdownTracing, err := telemetry.SetupTracing(ctx, cfg.TraceExporter)
 	if err != nil {
-		log.Fatalf("Failed to initialize Firebase App: %v", err)
+		fatal("Failed to initialize tracing", err)
 	}
-	authClient, err := app.Auth(ctx)
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Error("Failed to shut down tracing", slog.Any("error", err))
+		}
+	}()
+
+	tokenVerifier, err := newTokenVerifier(ctx, cfg)
 	if err != nil {
-		log.Fatalf("Failed to get Firebase Auth client: %v", err)
+		fatal("Failed to initialize Firebase Auth", err)
+	}
+	if cfg.Dev.Enabled {
+		logger.Warn("Running in development mode: upstream calls are unauthenticated",
+			slog.Bool("devTokens", cfg.Dev.AuthSecret != ""),
+			slog.String("authEmulatorHost", cfg.Dev.AuthEmulatorHost))
 	}
 
-	spec, err := openapi3.NewLoader().LoadFromFile("openapi/openapi.yaml")
+	rawSpec, spec, err := loadSpec(cfg.SpecPath)
 	if err != nil {
-		log.Fatalf("Failed to load OpenAPI spec: %v", err)
+		fatal("Failed to load OpenAPI spec", err)
+	}
+	docs, err := apidocs.New(rawSpec, spec)
+	if err != nil {
+		fatal("Failed to prepare API docs", err)
 	}
 
 	spec.Servers = nil
 
-	router := gin.Default()
-
-	router.Use(ginmiddleware.OapiRequestValidatorWithOptions(spec, &ginmiddleware.Options{
-		ErrorHandler: func(c *gin.Context, message string, statusCode int) {
-			if authStatusCode, authMessage, ok := middleware.GetAuthenticationError(c); ok {
-				c.AbortWithStatusJSON(authStatusCode, gin.H{"error": authMessage})
-				return
-			}
-			c.AbortWithStatusJSON(statusCode, gin.H{"error": message})
-		},
-		Options: openapi3filter.Options{
-			AuthenticationFunc: middleware.FirebaseAuthenticationFunc(authClient),
-		},
-	}))
-
-	clients, err := infrastructure.NewExternalClients(ctx)
+	m := metrics.New()
+	clients, err := infrastructure.NewExternalClients(ctx, cfg, m)
+	if err != nil {
+		fatal("Failed to initialize external clients", err)
+	}
+
+	checker := health.NewChecker(readinessTimeout)
+	checker.Add("openapiSpec", func(context.Context) error {
+		if spec.Paths == nil || spec.Paths.Len() == 0 {
+			return health.Fail(health.ReasonMisconfigured, errors.New("OpenAPI spec has no paths"))
+		}
+		return nil
+	})
+	checker.Add("firebaseAuth", func(context.Context) error {
+		if tokenVerifier == nil {
+			return health.Fail(health.ReasonMisconfigured, errors.New("Firebase Auth client is not initialized"))
+		}
+		return nil
+	})
+	checker.AddAll(clients.HealthChecks())
+
+	if os.Getenv(gin.EnvGinMode) == "" {
+		gin.SetMode(gin.ReleaseMode)
+	}
+	router := gin.New()
+	router.Use(middleware.RequestID(), middleware.Tracing(), middleware.RequestLogger(logger), middleware.Recovery(logger))
+
+	// OpenAPI 仕様に含まれない運用向けエンドポイントは validator より前に登録し、認証の対象外とする
+	router.GET("/healthz", health.Liveness())
+	router.GET("/readyz", health.Readiness(checker))
+	router.GET("/metrics", gin.WrapH(m.Handler()))
+	router.GET("/openapi.yaml", docs.YAML())
+	router.GET("/openapi.json", docs.JSON())
+	if cfg.DocsEnabled {
+		router.GET("/docs", docs.UI("/openapi.json"))
+	}
+
+	rolePolicy, err := middleware.NewRolePolicyFromSpec(spec)
+	if err != nil {
+		fatal("Failed to load role policy", err)
+	}
+	rolePolicy = rolePolicy.Restrict(cfg.AllowedRoles)
+
+	validator, err := middleware.OpenAPIValidator(spec, openapi3filter.Options{
+		AuthenticationFunc: middleware.FirebaseAuthenticationFunc(tokenVerifier, rolePolicy),
+	})
 	if err != nil {
-		log.Fatalf("Failed to initialize external clients: %v", err)
+		fatal("Failed to initialize OpenAPI validator", err)
+	}
+	auditLogs := audit.NewMemorySink(auditLogCapacity)
+	for operationID := range cfg.CacheTTLs {
+		if _, ok := rolePolicy.Roles(operationID); !ok {
+			logger.Warn("CACHE_TTLS refers to an unknown operation", slog.String("operationId", operationID))
+		}
 	}
+	responseCache := cache.New(cfg.CacheTTLs)
+	router.Use(
+		middleware.Metrics(m),
+		validator,
+		middleware.Audit(audit.MultiSink{audit.NewStdoutSink(), auditLogs}),
+		middleware.ETag(),
+		middleware.ResponseCache(responseCache, spec),
+	)
 
-	h := handler.NewHandler(clients.Academic, clients.Announcement, clients.Funch, clients.User)
+	templates, err := newTemplateStore(cfg.NotificationTemplateFile)
+	if err != nil {
+		fatal("Failed to open notification template store", err)
+	}
+
+	h := handler.NewHandler(clients.Academic, clients.Announcement, clients.Funch, clients.User, auditLogs, responseCache, templates, rolePolicy)
 	api.RegisterHandlers(router, h)
 
-	addr := ":8080"
-	log.Printf("Server starting on %s", addr)
-	if err := router.Run(addr); err != nil {
-		log.Fatal("Failed to start server:", err)
+	// SIGTERM / SIGINT を受けたら新しい接続の受け付けを止め、処理中のリクエストの完了を待つ
+	signalCtx, stop := signal.NotifyContext(ctx, syscall.SIGTERM, os.Interrupt)
+	defer stop()
+	if err := server.Run(signalCtx, router, cfg.ServerOptions()); err != nil {
+		logger.Error("Server stopped with error", slog.Any("error", err))
+	}
+	clients.Close()
+}
+
+// loadSpec は OpenAPI 仕様を読み込む
+// path が空の場合はバイナリに埋め込んだ仕様を使用する
+func loadSpec(path string) ([]byte, *openapi3.T, error) {
+	raw := openapi.Spec
+	if path != "" {
+		var err error
+		if raw, err = os.ReadFile(path); err != nil {
+			return nil, nil, err
+		}
+	}
+	spec, err := openapi3.NewLoader().LoadFromData(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	return raw, spec, nil
+}
+
+// newTemplateStore は通知テンプレートの保存先を作成する
+// path が空の場合はメモリ上に保持する; 設定の検証により path を省略できるのは開発モードのみ
+func newTemplateStore(path string) (notificationtemplate.Store, error) {
+	if path == "" {
+		slog.Warn("NOTIFICATION_TEMPLATE_FILE is not set in DEV_MODE: notification templates are kept in memory and lost on restart")
+		return notificationtemplate.NewMemoryStore(), nil
 	}
+	return notificationtemplate.OpenFileStore(path)
+}
+
+// devProjectID 開発モードで GOOGLE_CLOUD_PROJECT が未設定の場合に Firebase Auth エミュレータで使うプロジェクト ID
+const devProjectID = "demo-admin-bff"
+
+// newTokenVerifier は ID トークンの検証に使用する TokenVerifier を作成する
+// 開発モードでは DEV_AUTH_SECRET で署名された開発用トークンと Firebase Auth エミュレータのトークンを受け付ける
+func newTokenVerifier(ctx context.Context, cfg *config.Config) (middleware.TokenVerifier, error) {
+	var firebaseConfig *firebase.Config
+	if cfg.Dev.Enabled {
+		if cfg.Dev.AuthEmulatorHost == "" {
+			return middleware.NewDevTokenVerifier([]byte(cfg.Dev.AuthSecret), nil), nil
+		}
+		projectID := cfg.Dev.ProjectID
+		if projectID == "" {
+			projectID = devProjectID
+		}
+		firebaseConfig = &firebase.Config{ProjectID: projectID}
+		// Firebase SDK は環境変数からエミュレータを判定するため、YAML で指定された場合も反映する
+		if err := os.Setenv("FIREBASE_AUTH_EMULATOR_HOST", cfg.Dev.AuthEmulatorHost); err != nil {
+			return nil, err
+		}
+	}
+
+	app, err := firebase.NewApp(ctx, firebaseConfig)
+	if err != nil {
+		return nil, err
+	}
+	authClient, err := app.Auth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Dev.Enabled && cfg.Dev.AuthSecret != "" {
+		return middleware.NewDevTokenVerifier([]byte(cfg.Dev.AuthSecret), authClient), nil
+	}
+	return authClient, nil
+}
+
+// fatal はエラーを ERROR ログとして出力してプロセスを終了する
+func fatal(message string, err error) {
+	slog.Error(message, slog.Any("error", err))
+	os.Exit(1)
 }
@@ -20,6 +20,13 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for AnnouncementServiceAnnouncementStatus.
+const (
+	AnnouncementServiceAnnouncementStatusActive    AnnouncementServiceAnnouncementStatus = "active"
+	AnnouncementServiceAnnouncementStatusExpired   AnnouncementServiceAnnouncementStatus = "expired"
+	AnnouncementServiceAnnouncementStatusScheduled AnnouncementServiceAnnouncementStatus = "scheduled"
+)
+
 // Defines values for DottoFoundationV1Class.
 const (
 	A DottoFoundationV1Class = "A"
@@ -121,6 +128,12 @@ const (
 	RoomChanged DottoFoundationV1PersonalCalendarItemStatus = "RoomChanged"
 )
 
+// Defines values for DottoFoundationV1SortDirection.
+const (
+	Asc  DottoFoundationV1SortDirection = "asc"
+	Desc DottoFoundationV1SortDirection = "desc"
+)
+
 // Defines values for DottoFoundationV1SubjectClassification.
 const (
 	Cultural            DottoFoundationV1SubjectClassification = "Cultural"
@@ -151,6 +164,16 @@ const (
 	Small  FunchServiceSize = "Small"
 )
 
+// Defines values for NotificationDeliveryStatus.
+const (
+	NotificationDeliveryStatusDelivered          NotificationDeliveryStatus = "delivered"
+	NotificationDeliveryStatusDraft              NotificationDeliveryStatus = "draft"
+	NotificationDeliveryStatusExpired            NotificationDeliveryStatus = "expired"
+	NotificationDeliveryStatusPartiallyDelivered NotificationDeliveryStatus = "partiallyDelivered"
+	NotificationDeliveryStatusScheduled          NotificationDeliveryStatus = "scheduled"
+	NotificationDeliveryStatusSending            NotificationDeliveryStatus = "sending"
+)
+
 // AcademicServiceCancelledClass 休講
 type AcademicServiceCancelledClass struct {
 	Comment string                  `json:"comment"`
@@ -346,6 +369,90 @@ type AcademicServiceSubjectTargetClass struct {
 	Grade DottoFoundationV1Grade `json:"grade"`
 }
 
+// AcademicServiceSyllabus defines model for AcademicService.Syllabus.
+type AcademicServiceSyllabus struct {
+	// Assignments 提出課題等
+	Assignments string `json:"assignments"`
+
+	// Classifications 科目群・科目区分
+	Classifications string `json:"classifications"`
+
+	// ContentsAndSchedule 授業内容とスケジュール
+	ContentsAndSchedule string `json:"contentsAndSchedule"`
+
+	// Credit 単位数
+	Credit int `json:"credit"`
+
+	// DsopSubject DSOP対象科目
+	DsopSubject string `json:"dsopSubject"`
+
+	// EnName 授業名 (en)
+	EnName string `json:"enName"`
+
+	// EvaluationMethod 成績の評価方法・基準
+	EvaluationMethod string `json:"evaluationMethod"`
+
+	// FacultyNames 担当教員名
+	FacultyNames string `json:"facultyNames"`
+
+	// Grades 配当年次
+	Grades string `json:"grades"`
+
+	// Id 教務システムのシラバスID
+	Id string `json:"id"`
+
+	// Keywords キーワード
+	Keywords string `json:"keywords"`
+
+	// LearningOutcomes 授業の到達目標
+	LearningOutcomes string `json:"learningOutcomes"`
+
+	// MultiplePersonTeachingForm 複数人担当形式
+	MultiplePersonTeachingForm string `json:"multiplePersonTeachingForm"`
+
+	// Name 授業名
+	Name string `json:"name"`
+
+	// Notes 履修上の留意点
+	Notes string `json:"notes"`
+
+	// PostLearning 事後学習
+	PostLearning string `json:"postLearning"`
+
+	// PracticalHomeFacultyCategory 実務家教員区分
+	PracticalHomeFacultyCategory string `json:"practicalHomeFacultyCategory"`
+
+	// PreLearning 事前学習
+	PreLearning string `json:"preLearning"`
+
+	// Prerequisites 履修条件
+	Prerequisites string `json:"prerequisites"`
+
+	// ReferenceBooks 参考書
+	ReferenceBooks string `json:"referenceBooks"`
+
+	// Summary 授業の概要
+	Summary string `json:"summary"`
+
+	// TargetAreas 対象領域
+	TargetAreas string `json:"targetAreas"`
+
+	// TargetCourses 対象コース・領域
+	TargetCourses string `json:"targetCourses"`
+
+	// TeachingAndExamForm 授業・試験の形式
+	TeachingAndExamForm string `json:"teachingAndExamForm"`
+
+	// TeachingForm 授業形態
+	TeachingForm string `json:"teachingForm"`
+
+	// TeachingLanguage 教授言語
+	TeachingLanguage string `json:"teachingLanguage"`
+
+	// Textbooks テキスト
+	Textbooks string `json:"textbooks"`
+}
+
 // AcademicServiceTimetableItem defines model for AcademicService.TimetableItem.
 type AcademicServiceTimetableItem struct {
 	Id    string                `json:"id"`
@@ -368,8 +475,11 @@ type AnnouncementServiceAnnouncement struct {
 	AvailableFrom  time.Time  `json:"availableFrom"`
 	AvailableUntil *time.Time `json:"availableUntil,omitempty"`
 	Id             string     `json:"id"`
-	Title          string     `json:"title"`
-	Url            string     `json:"url"`
+
+	// Status 公開期間と現在時刻から算出した公開状態
+	Status AnnouncementServiceAnnouncementStatus `json:"status"`
+	Title  string                                `json:"title"`
+	Url    string                                `json:"url"`
 }
 
 // AnnouncementServiceAnnouncementRequest defines model for AnnouncementService.AnnouncementRequest.
@@ -380,6 +490,34 @@ type AnnouncementServiceAnnouncementRequest struct {
 	Url            string     `json:"url"`
 }
 
+// AnnouncementServiceAnnouncementStatus 公開状態
+//
+// `scheduled`: 公開開始前、`active`: 公開中、`expired`: 公開終了
+type AnnouncementServiceAnnouncementStatus string
+
+// AuditLog 管理操作の監査ログ
+type AuditLog struct {
+	// ActorUid 操作したユーザーの Firebase UID
+	ActorUid    string `json:"actorUid"`
+	Method      string `json:"method"`
+	OperationId string `json:"operationId"`
+	Path        string `json:"path"`
+
+	// Query リクエストのクエリ文字列; dryRun=true などの副作用の有無を変えるパラメータを区別するために記録する
+	Query *string `json:"query,omitempty"`
+
+	// RequestBody リクエストボディ
+	RequestBody interface{} `json:"requestBody,omitempty"`
+	RequestId   *string     `json:"requestId,omitempty"`
+
+	// ResourceId 操作対象のリソースID; 作成の場合は作成されたリソースのID
+	ResourceId *string `json:"resourceId,omitempty"`
+
+	// StatusCode BFF が返したステータスコード
+	StatusCode int       `json:"statusCode"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
 // DottoFoundationV1Class クラス
 type DottoFoundationV1Class string
 
@@ -407,6 +545,9 @@ type DottoFoundationV1Period string
 // DottoFoundationV1PersonalCalendarItemStatus 教室変更よりも休講・補講が優先される
 type DottoFoundationV1PersonalCalendarItemStatus string
 
+// DottoFoundationV1SortDirection defines model for DottoFoundationV1.SortDirection.
+type DottoFoundationV1SortDirection string
+
 // DottoFoundationV1SubjectClassification 科目カテゴリ
 type DottoFoundationV1SubjectClassification string
 
@@ -444,6 +585,313 @@ type FunchServicePrice struct {
 // FunchServiceSize defines model for FunchService.Size.
 type FunchServiceSize string
 
+// NotificationAudience 通知の対象ユーザーを表す条件式
+//
+// 指定した条件は全て満たす必要がある。各配列は、いずれかの値に一致すれば条件を満たす。
+// `union` は子の条件式のいずれかに、`intersection` は子の条件式の全てに一致するユーザーを表す。
+// `exclude` に一致するユーザーは対象から除く。
+// 誤って全員に送信しないよう、入れ子を含む全ての条件式で `exclude` 以外の条件を 1 つ以上指定する必要がある。
+// 全ユーザーを対象とする場合は `allUsers` に true を指定する。
+type NotificationAudience struct {
+	// AllUsers true の場合は全ユーザーを対象とする; `exclude` と組み合わせて「〜を除く全員」を表す
+	AllUsers *bool `json:"allUsers,omitempty"`
+
+	// Classes クラス
+	Classes *[]DottoFoundationV1Class `json:"classes,omitempty"`
+
+	// Courses コース
+	Courses *[]DottoFoundationV1Course `json:"courses,omitempty"`
+
+	// Exclude 通知の対象ユーザーを表す条件式
+	//
+	// 指定した条件は全て満たす必要がある。各配列は、いずれかの値に一致すれば条件を満たす。
+	// `union` は子の条件式のいずれかに、`intersection` は子の条件式の全てに一致するユーザーを表す。
+	// `exclude` に一致するユーザーは対象から除く。
+	// 誤って全員に送信しないよう、入れ子を含む全ての条件式で `exclude` 以外の条件を 1 つ以上指定する必要がある。
+	// 全ユーザーを対象とする場合は `allUsers` に true を指定する。
+	Exclude *NotificationAudience `json:"exclude,omitempty"`
+
+	// Grades 学年
+	Grades *[]DottoFoundationV1Grade `json:"grades,omitempty"`
+
+	// Intersection 全てに一致するユーザー
+	Intersection *[]NotificationAudience `json:"intersection,omitempty"`
+
+	// SubjectIds 履修登録している科目のID
+	SubjectIds *[]string `json:"subjectIds,omitempty"`
+
+	// Union いずれかに一致するユーザー
+	Union *[]NotificationAudience `json:"union,omitempty"`
+
+	// UserIds ユーザーID (Firebase Authentication の UID)
+	UserIds *[]string `json:"userIds,omitempty"`
+}
+
+// NotificationContent 通知の内容; UserService.NotificationRequest から対象ユーザーを除いたもの
+type NotificationContent struct {
+	// AnalyticsLabel Firebase Analytics に記録する分析ラベル
+	AnalyticsLabel *string `json:"analyticsLabel,omitempty"`
+
+	// AndroidChannelId Android の通知チャンネルID
+	AndroidChannelId *string `json:"androidChannelId,omitempty"`
+
+	// AndroidPriority Android の通知優先度（"normal" または "high"）
+	AndroidPriority *string `json:"androidPriority,omitempty"`
+
+	// AndroidTtlSeconds Android の通知TTL（秒）
+	AndroidTtlSeconds *int `json:"androidTtlSeconds,omitempty"`
+
+	// ApnsBadge APNs のバッジ数
+	ApnsBadge *int `json:"apnsBadge,omitempty"`
+
+	// ApnsContentAvailable APNs の content-available フラグ（true でサイレントプッシュ）
+	ApnsContentAvailable *bool `json:"apnsContentAvailable,omitempty"`
+
+	// ApnsSound APNs の通知音
+	//
+	// - "default": OS 標準の通知音
+	// - "" または省略: 無音
+	// - 任意のファイル名（例: "alert.caf"）: アプリバンドルまたは Library/Sounds/ に同梱されたカスタムサウンド
+	// 対応フォーマットは .caf / .aiff / .wav（Linear PCM, MA4, µ-law, a-law）、最大30秒
+	ApnsSound *string `json:"apnsSound,omitempty"`
+
+	// Body 通知本文
+	Body string `json:"body"`
+
+	// ImageUrl 通知に表示する画像のURL
+	ImageUrl *string `json:"imageUrl,omitempty"`
+
+	// NotifyAfter 通知送信可能になる日時（この時刻以降に送信対象となる）
+	NotifyAfter time.Time `json:"notifyAfter"`
+
+	// NotifyBefore 通知送信期限日時（この時刻を過ぎた場合は送信しない）
+	NotifyBefore time.Time `json:"notifyBefore"`
+
+	// Title 通知タイトル
+	Title string `json:"title"`
+
+	// Url 通知をタップした時に開くURL
+	// アプリを開くのみの場合は未指定
+	Url *string `json:"url,omitempty"`
+
+	// WebpushLink Web Push 通知をクリックした際に開くURL
+	WebpushLink *string `json:"webpushLink,omitempty"`
+}
+
+// NotificationDeliveryStatus 通知の配信状況
+//
+// `draft`: 対象ユーザーがいない、`scheduled`: 通知予定期間の開始前、`sending`: 通知予定期間中で未配信のユーザーがいる、
+// `partiallyDelivered`: 通知予定期間を過ぎ、一部のユーザーに配信されなかった、`delivered`: 全ての対象ユーザーに配信済み、
+// `expired`: 通知予定期間を過ぎ、誰にも配信されなかった
+type NotificationDeliveryStatus string
+
+// NotificationLifecycle defines model for NotificationLifecycle.
+type NotificationLifecycle struct {
+	// DeliveredCount 配信済みの対象ユーザー数
+	DeliveredCount int `json:"deliveredCount"`
+
+	// Id 通知ID
+	Id string `json:"id"`
+
+	// NotifyAfter 通知送信可能になる日時
+	NotifyAfter time.Time `json:"notifyAfter"`
+
+	// NotifyBefore 通知送信期限日時
+	NotifyBefore time.Time `json:"notifyBefore"`
+
+	// PendingCount 未配信の対象ユーザー数
+	PendingCount int `json:"pendingCount"`
+
+	// Status 通知の配信状況
+	//
+	// `draft`: 対象ユーザーがいない、`scheduled`: 通知予定期間の開始前、`sending`: 通知予定期間中で未配信のユーザーがいる、
+	// `partiallyDelivered`: 通知予定期間を過ぎ、一部のユーザーに配信されなかった、`delivered`: 全ての対象ユーザーに配信済み、
+	// `expired`: 通知予定期間を過ぎ、誰にも配信されなかった
+	Status NotificationDeliveryStatus `json:"status"`
+
+	// Title 通知タイトル
+	Title string `json:"title"`
+
+	// UndeliveredUserIds 未配信の対象ユーザーID
+	UndeliveredUserIds []string `json:"undeliveredUserIds"`
+}
+
+// NotificationTemplate 通知テンプレート
+//
+// apnsBadge / apnsContentAvailable / androidTtlSeconds 以外の文字列の項目には `{name}` 形式のプレースホルダーを含めることができる
+type NotificationTemplate struct {
+	// AnalyticsLabel Firebase Analytics に記録する分析ラベル
+	AnalyticsLabel *string `json:"analyticsLabel,omitempty"`
+
+	// AndroidChannelId Android の通知チャンネルID
+	AndroidChannelId *string `json:"androidChannelId,omitempty"`
+
+	// AndroidPriority Android の通知優先度
+	AndroidPriority *string `json:"androidPriority,omitempty"`
+
+	// AndroidTtlSeconds Android の通知TTL（秒）
+	AndroidTtlSeconds *int `json:"androidTtlSeconds,omitempty"`
+
+	// ApnsBadge APNs のバッジ数
+	ApnsBadge *int `json:"apnsBadge,omitempty"`
+
+	// ApnsContentAvailable APNs の content-available フラグ
+	ApnsContentAvailable *bool `json:"apnsContentAvailable,omitempty"`
+
+	// ApnsSound APNs の通知音
+	ApnsSound *string `json:"apnsSound,omitempty"`
+
+	// Body 通知本文
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Description テンプレートの説明
+	Description *string `json:"description,omitempty"`
+
+	// Id テンプレートID
+	Id string `json:"id"`
+
+	// ImageUrl 通知に表示する画像のURL
+	ImageUrl *string `json:"imageUrl,omitempty"`
+
+	// Name テンプレート名; テンプレート間で一意
+	Name string `json:"name"`
+
+	// Placeholders テンプレートに含まれるプレースホルダーの名前
+	Placeholders []string `json:"placeholders"`
+
+	// Title 通知タイトル
+	Title     string    `json:"title"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Url 通知をタップした時に開くURL
+	Url *string `json:"url,omitempty"`
+
+	// WebpushLink Web Push 通知をクリックした際に開くURL
+	WebpushLink *string `json:"webpushLink,omitempty"`
+}
+
+// NotificationTemplateRenderRequest defines model for NotificationTemplateRenderRequest.
+type NotificationTemplateRenderRequest struct {
+	// Audience 通知の対象ユーザーを表す条件式
+	//
+	// 指定した条件は全て満たす必要がある。各配列は、いずれかの値に一致すれば条件を満たす。
+	// `union` は子の条件式のいずれかに、`intersection` は子の条件式の全てに一致するユーザーを表す。
+	// `exclude` に一致するユーザーは対象から除く。
+	// 誤って全員に送信しないよう、入れ子を含む全ての条件式で `exclude` 以外の条件を 1 つ以上指定する必要がある。
+	// 全ユーザーを対象とする場合は `allUsers` に true を指定する。
+	Audience *NotificationAudience `json:"audience,omitempty"`
+
+	// NotifyAfter 通知送信可能になる日時
+	NotifyAfter time.Time `json:"notifyAfter"`
+
+	// NotifyBefore 通知送信期限日時
+	NotifyBefore time.Time `json:"notifyBefore"`
+
+	// TargetUserIds 対象ユーザーIDのリスト
+	TargetUserIds *[]string `json:"targetUserIds,omitempty"`
+
+	// Variables プレースホルダーに埋め込む値
+	Variables map[string]string `json:"variables"`
+}
+
+// NotificationTemplateRequest defines model for NotificationTemplateRequest.
+type NotificationTemplateRequest struct {
+	// AnalyticsLabel Firebase Analytics に記録する分析ラベル
+	AnalyticsLabel *string `json:"analyticsLabel,omitempty"`
+
+	// AndroidChannelId Android の通知チャンネルID
+	AndroidChannelId *string `json:"androidChannelId,omitempty"`
+
+	// AndroidPriority Android の通知優先度
+	AndroidPriority *string `json:"androidPriority,omitempty"`
+
+	// AndroidTtlSeconds Android の通知TTL（秒）
+	AndroidTtlSeconds *int `json:"androidTtlSeconds,omitempty"`
+
+	// ApnsBadge APNs のバッジ数
+	ApnsBadge *int `json:"apnsBadge,omitempty"`
+
+	// ApnsContentAvailable APNs の content-available フラグ
+	ApnsContentAvailable *bool `json:"apnsContentAvailable,omitempty"`
+
+	// ApnsSound APNs の通知音
+	ApnsSound *string `json:"apnsSound,omitempty"`
+
+	// Body 通知本文
+	Body string `json:"body"`
+
+	// Description テンプレートの説明
+	Description *string `json:"description,omitempty"`
+
+	// ImageUrl 通知に表示する画像のURL
+	ImageUrl *string `json:"imageUrl,omitempty"`
+
+	// Name テンプレート名; テンプレート間で一意
+	Name string `json:"name"`
+
+	// Title 通知タイトル
+	Title string `json:"title"`
+
+	// Url 通知をタップした時に開くURL
+	Url *string `json:"url,omitempty"`
+
+	// WebpushLink Web Push 通知をクリックした際に開くURL
+	WebpushLink *string `json:"webpushLink,omitempty"`
+}
+
+// Problem RFC 7807 Problem Details
+type Problem struct {
+	// Code 機械判読可能なエラーコード
+	Code *string `json:"code,omitempty"`
+
+	// Detail このエラーに固有の説明
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors 検証に失敗した項目
+	Errors *[]ProblemFieldError `json:"errors,omitempty"`
+
+	// Instance エラーが発生したリクエストのパス
+	Instance *string `json:"instance,omitempty"`
+
+	// RequestId リクエストID
+	RequestId *string `json:"requestId,omitempty"`
+
+	// Status HTTP ステータスコード
+	Status int `json:"status"`
+
+	// Title ステータスコードに対応する概要
+	Title string `json:"title"`
+
+	// Type エラー種別を表す URI; 個別の種別がない場合は `about:blank`
+	Type string `json:"type"`
+}
+
+// ProblemFieldError 検証に失敗した項目
+type ProblemFieldError struct {
+	// Field 項目名; リクエストボディの場合は JSON Pointer
+	Field string `json:"field"`
+
+	// In 項目の位置 (path / query / header / body)
+	In      string `json:"in"`
+	Message string `json:"message"`
+}
+
+// UserFacets ユーザー検索の絞り込み条件ごとの件数
+//
+// 各項目の件数は、その項目以外の絞り込み条件に一致するユーザーを値ごとに数えたもの
+// 値が未設定のユーザーは数えない
+type UserFacets struct {
+	// Classes クラスごとの件数
+	Classes map[string]int `json:"classes"`
+
+	// Courses コースごとの件数
+	Courses map[string]int `json:"courses"`
+
+	// Grades 学年ごとの件数
+	Grades map[string]int `json:"grades"`
+}
+
 // UserServiceFCMToken defines model for UserService.FCMToken.
 type UserServiceFCMToken struct {
 	// CreatedAt 作成日時
@@ -634,40 +1082,175 @@ type UserServiceUserRequest struct {
 	Grade *DottoFoundationV1Grade `json:"grade,omitempty"`
 }
 
-// CancelledClassesV1ListParams defines parameters for CancelledClassesV1List.
-type CancelledClassesV1ListParams struct {
-	// SubjectIds 科目IDのリスト; 指定した科目の休講のみを取得する; 指定しない場合は全科目を検索対象とする
-	SubjectIds *[]string `form:"subjectIds,omitempty" json:"subjectIds,omitempty"`
+// IfMatch defines model for IfMatch.
+type IfMatch = string
 
-	// From 検索対象開始日付
-	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+// NotifyRegistrants defines model for NotifyRegistrants.
+type NotifyRegistrants = bool
 
-	// Until 検索対象終了日付
-	Until *openapi_types.Date `form:"until,omitempty" json:"until,omitempty"`
-}
+// PageCursor defines model for PageCursor.
+type PageCursor = string
 
-// CourseRegistrationsV1ListParams defines parameters for CourseRegistrationsV1List.
-type CourseRegistrationsV1ListParams struct {
-	// UserId ユーザーID
-	UserId string `form:"userId" json:"userId"`
+// PageLimit defines model for PageLimit.
+type PageLimit = int
 
-	// Year 開講年度; 指定しない場合は今年度が選択される
-	Year *int `form:"year,omitempty" json:"year,omitempty"`
+// BadRequest RFC 7807 Problem Details
+type BadRequest = Problem
+
+// Conflict RFC 7807 Problem Details
+type Conflict = Problem
+
+// Forbidden RFC 7807 Problem Details
+type Forbidden = Problem
+
+// NotFound RFC 7807 Problem Details
+type NotFound = Problem
+
+// PreconditionFailed RFC 7807 Problem Details
+type PreconditionFailed = Problem
+
+// Unauthorized RFC 7807 Problem Details
+type Unauthorized = Problem
+
+// UnprocessableEntity RFC 7807 Problem Details
+type UnprocessableEntity = Problem
+
+// AnnouncementsV1ListParams defines parameters for AnnouncementsV1List.
+type AnnouncementsV1ListParams struct {
+	// SortByDate 日時ソート
+	//
+	// 昇順ソートの場合は`asc`を指定、降順ソートの場合は`desc`を指定
+	SortByDate *DottoFoundationV1SortDirection `form:"sortByDate,omitempty" json:"sortByDate,omitempty"`
+
+	// FilterIsActive 公開状態で絞り込むか
+	//
+	// 公開状態のみを抽出する場合は`true`を指定
+	FilterIsActive *bool `form:"filterIsActive,omitempty" json:"filterIsActive,omitempty"`
+
+	// Statuses 公開状態; 指定した公開状態のおしらせのみを取得する
+	Statuses *[]AnnouncementServiceAnnouncementStatus `form:"statuses,omitempty" json:"statuses,omitempty"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-id)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// AnnouncementsV1UpdateParams defines parameters for AnnouncementsV1Update.
+type AnnouncementsV1UpdateParams struct {
+	// IfMatch 更新対象の ETag
+	//
+	// 詳細取得や更新のレスポンスの ETag ヘッダーを指定すると、他の管理者が先に更新していた場合に 412 を返す
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// AuditLogsV1ListParams defines parameters for AuditLogsV1List.
+type AuditLogsV1ListParams struct {
+	// ActorUid 操作したユーザーの Firebase UID
+	ActorUid *string `form:"actorUid,omitempty" json:"actorUid,omitempty"`
+
+	// OperationId 操作の operationId
+	OperationId *string `form:"operationId,omitempty" json:"operationId,omitempty"`
+
+	// Since 指定日時以降の操作のみを取得する
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Limit 取得件数
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CancelledClassesV1ListParams defines parameters for CancelledClassesV1List.
+type CancelledClassesV1ListParams struct {
+	// SubjectIds 科目IDのリスト; 指定した科目の休講のみを取得する; 指定しない場合は全科目を検索対象とする
+	SubjectIds *[]string `form:"subjectIds,omitempty" json:"subjectIds,omitempty"`
+
+	// From 検索対象開始日付
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// Until 検索対象終了日付
+	Until *openapi_types.Date `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-id)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// CancelledClassesV1CreateParams defines parameters for CancelledClassesV1Create.
+type CancelledClassesV1CreateParams struct {
+	// Notify true の場合、作成後に科目の履修者へ通知を作成する
+	//
+	// 通知の作成には NotificationV1_create と同じロール (admin / developer) が必要で、持たない場合は何も作成せず `403 Forbidden` を返す。
+	// 通知の作成に失敗しても作成した内容は取り消さず、notificationError に失敗の内容を返す
+	// 授業日を過ぎている場合は通知を作成せず、notificationError に CLASS_ALREADY_ENDED を返す
+	Notify *NotifyRegistrants `form:"notify,omitempty" json:"notify,omitempty"`
+}
+
+// CourseRegistrationsV1ListParams defines parameters for CourseRegistrationsV1List.
+type CourseRegistrationsV1ListParams struct {
+	// UserId ユーザーID
+	UserId string `form:"userId" json:"userId"`
+
+	// Year 開講年度; 指定しない場合は今年度が選択される
+	Year *int `form:"year,omitempty" json:"year,omitempty"`
 
 	// Semesters 開講時期
 	Semesters []DottoFoundationV1CourseSemester `form:"semesters" json:"semesters"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-id)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // FacultiesV1ListParams defines parameters for FacultiesV1List.
 type FacultiesV1ListParams struct {
 	// Q 検索ワード; 教員の名前で部分一致検索される
 	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-id)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// FacultiesV1UpdateParams defines parameters for FacultiesV1Update.
+type FacultiesV1UpdateParams struct {
+	// IfMatch 更新対象の ETag
+	//
+	// 詳細取得や更新のレスポンスの ETag ヘッダーを指定すると、他の管理者が先に更新していた場合に 412 を返す
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
 }
 
 // FacultyRoomsV1ListParams defines parameters for FacultyRoomsV1List.
 type FacultyRoomsV1ListParams struct {
 	// Year 年度; 指定しない場合は今年度が選択される
 	Year *int `form:"year,omitempty" json:"year,omitempty"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-id)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // FCMTokenV1ListParams defines parameters for FCMTokenV1List.
@@ -683,6 +1266,15 @@ type FCMTokenV1ListParams struct {
 
 	// UpdatedAtTo 更新日時の終了日時 (updatedAt <= updatedAtTo)
 	UpdatedAtTo *time.Time `form:"updatedAtTo,omitempty" json:"updatedAtTo,omitempty"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-token)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // MakeupClassesV1ListParams defines parameters for MakeupClassesV1List.
@@ -695,12 +1287,40 @@ type MakeupClassesV1ListParams struct {
 
 	// Until 検索対象終了日付
 	Until *openapi_types.Date `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-id)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// MakeupClassesV1CreateParams defines parameters for MakeupClassesV1Create.
+type MakeupClassesV1CreateParams struct {
+	// Notify true の場合、作成後に科目の履修者へ通知を作成する
+	//
+	// 通知の作成には NotificationV1_create と同じロール (admin / developer) が必要で、持たない場合は何も作成せず `403 Forbidden` を返す。
+	// 通知の作成に失敗しても作成した内容は取り消さず、notificationError に失敗の内容を返す
+	// 授業日を過ぎている場合は通知を作成せず、notificationError に CLASS_ALREADY_ENDED を返す
+	Notify *NotifyRegistrants `form:"notify,omitempty" json:"notify,omitempty"`
 }
 
 // MenuItemsV1ListParams defines parameters for MenuItemsV1List.
 type MenuItemsV1ListParams struct {
 	// Date メニューを取得する日付
 	Date openapi_types.Date `form:"date" json:"date"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-id)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // NotificationV1ListParams defines parameters for NotificationV1List.
@@ -713,6 +1333,42 @@ type NotificationV1ListParams struct {
 
 	// IsNotified 通知済みかどうか (true: 通知済みの通知のみ、false: 通知未済みの通知のみ、指定なし: 全ての通知)
 	IsNotified *bool `form:"isNotified,omitempty" json:"isNotified,omitempty"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-id)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// NotificationV1CreateForAudienceJSONBody defines parameters for NotificationV1CreateForAudience.
+type NotificationV1CreateForAudienceJSONBody struct {
+	// Audience 通知の対象ユーザーを表す条件式
+	//
+	// 指定した条件は全て満たす必要がある。各配列は、いずれかの値に一致すれば条件を満たす。
+	// `union` は子の条件式のいずれかに、`intersection` は子の条件式の全てに一致するユーザーを表す。
+	// `exclude` に一致するユーザーは対象から除く。
+	// 誤って全員に送信しないよう、入れ子を含む全ての条件式で `exclude` 以外の条件を 1 つ以上指定する必要がある。
+	// 全ユーザーを対象とする場合は `allUsers` に true を指定する。
+	Audience NotificationAudience `json:"audience"`
+
+	// Notification 通知の内容; UserService.NotificationRequest から対象ユーザーを除いたもの
+	Notification NotificationContent `json:"notification"`
+}
+
+// NotificationV1PreviewAudienceJSONBody defines parameters for NotificationV1PreviewAudience.
+type NotificationV1PreviewAudienceJSONBody struct {
+	// Audience 通知の対象ユーザーを表す条件式
+	//
+	// 指定した条件は全て満たす必要がある。各配列は、いずれかの値に一致すれば条件を満たす。
+	// `union` は子の条件式のいずれかに、`intersection` は子の条件式の全てに一致するユーザーを表す。
+	// `exclude` に一致するユーザーは対象から除く。
+	// 誤って全員に送信しないよう、入れ子を含む全ての条件式で `exclude` 以外の条件を 1 つ以上指定する必要がある。
+	// 全ユーザーを対象とする場合は `allUsers` に true を指定する。
+	Audience NotificationAudience `json:"audience"`
 }
 
 // NotificationV1DispatchJSONBody defines parameters for NotificationV1Dispatch.
@@ -720,6 +1376,38 @@ type NotificationV1DispatchJSONBody struct {
 	NotificationIds []string `json:"notificationIds"`
 }
 
+// NotificationV1DispatchParams defines parameters for NotificationV1Dispatch.
+type NotificationV1DispatchParams struct {
+	// DryRun true の場合は送信せず、送信される内容のみを返す
+	DryRun *bool `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// NotificationV1LifecycleParams defines parameters for NotificationV1Lifecycle.
+type NotificationV1LifecycleParams struct {
+	// NotifyAtFrom 通知予定期間の開始日時（通知ウィンドウがこの範囲と重なるものを抽出: notifyBefore >= notifyAtFrom）
+	NotifyAtFrom *time.Time `form:"notifyAtFrom,omitempty" json:"notifyAtFrom,omitempty"`
+
+	// NotifyAtTo 通知予定期間の終了日時（通知ウィンドウがこの範囲と重なるものを抽出: notifyAfter <= notifyAtTo）
+	NotifyAtTo *time.Time `form:"notifyAtTo,omitempty" json:"notifyAtTo,omitempty"`
+
+	// Statuses 配信状況; 指定した場合は指定した配信状況の通知のみを取得する
+	Statuses *[]NotificationDeliveryStatus `form:"statuses,omitempty" json:"statuses,omitempty"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// NotificationV1UpdateParams defines parameters for NotificationV1Update.
+type NotificationV1UpdateParams struct {
+	// IfMatch 更新対象の ETag
+	//
+	// 詳細取得や更新のレスポンスの ETag ヘッダーを指定すると、他の管理者が先に更新していた場合に 412 を返す
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // PersonalCalendarItemsV1ListParams defines parameters for PersonalCalendarItemsV1List.
 type PersonalCalendarItemsV1ListParams struct {
 	// UserId ユーザーID
@@ -727,6 +1415,15 @@ type PersonalCalendarItemsV1ListParams struct {
 
 	// Dates 日付のリスト; 指定した日付の個人カレンダーアイテムのみを取得する
 	Dates []openapi_types.Date `form:"dates" json:"dates"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-date)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // ReservationsV1ListParams defines parameters for ReservationsV1List.
@@ -739,6 +1436,15 @@ type ReservationsV1ListParams struct {
 
 	// Until 検索対象終了日時
 	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-id)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // RoomChangesV1ListParams defines parameters for RoomChangesV1List.
@@ -751,6 +1457,25 @@ type RoomChangesV1ListParams struct {
 
 	// Until 検索対象終了日付
 	Until *openapi_types.Date `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-id)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// RoomChangesV1CreateParams defines parameters for RoomChangesV1Create.
+type RoomChangesV1CreateParams struct {
+	// Notify true の場合、作成後に科目の履修者へ通知を作成する
+	//
+	// 通知の作成には NotificationV1_create と同じロール (admin / developer) が必要で、持たない場合は何も作成せず `403 Forbidden` を返す。
+	// 通知の作成に失敗しても作成した内容は取り消さず、notificationError に失敗の内容を返す
+	// 授業日を過ぎている場合は通知を作成せず、notificationError に CLASS_ALREADY_ENDED を返す
+	Notify *NotifyRegistrants `form:"notify,omitempty" json:"notify,omitempty"`
 }
 
 // RoomsV1ListParams defines parameters for RoomsV1List.
@@ -760,6 +1485,23 @@ type RoomsV1ListParams struct {
 
 	// Floors 階数; 指定した場合は指定した階数の部屋のみを取得する
 	Floors *[]DottoFoundationV1Floor `form:"floors,omitempty" json:"floors,omitempty"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-id)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// RoomsV1UpdateParams defines parameters for RoomsV1Update.
+type RoomsV1UpdateParams struct {
+	// IfMatch 更新対象の ETag
+	//
+	// 詳細取得や更新のレスポンスの ETag ヘッダーを指定すると、他の管理者が先に更新していた場合に 412 を返す
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
 }
 
 // SubjectsV1ListParams defines parameters for SubjectsV1List.
@@ -790,6 +1532,15 @@ type SubjectsV1ListParams struct {
 
 	// CulturalSubjectCategories 教養科目カテゴリ
 	CulturalSubjectCategories *[]DottoFoundationV1CulturalSubjectCategory `form:"culturalSubjectCategories,omitempty" json:"culturalSubjectCategories,omitempty"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-id)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // TimetableItemsV1ListParams defines parameters for TimetableItemsV1List.
@@ -799,6 +1550,39 @@ type TimetableItemsV1ListParams struct {
 
 	// Semesters 開講時期
 	Semesters []DottoFoundationV1CourseSemester `form:"semesters" json:"semesters"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-id)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// UsersV1ListParams defines parameters for UsersV1List.
+type UsersV1ListParams struct {
+	// Grades 学年
+	Grades *[]DottoFoundationV1Grade `form:"grades,omitempty" json:"grades,omitempty"`
+
+	// Courses コース
+	Courses *[]DottoFoundationV1Course `form:"courses,omitempty" json:"courses,omitempty"`
+
+	// Classes クラス
+	Classes *[]DottoFoundationV1Class `form:"classes,omitempty" json:"classes,omitempty"`
+
+	// Email メールアドレス; 大文字・小文字を区別せず部分一致検索される
+	Email *string `form:"email,omitempty" json:"email,omitempty"`
+
+	// Limit 1 ページの取得件数; 指定しない場合は全件を取得する
+	Limit *PageLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスの nextCursor; 指定しない場合は先頭から取得する
+	Cursor *PageCursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Sort 並び順; カンマ区切りのフィールド名で指定し、先頭に - を付けると降順になる (例：-id)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 }
 
 // AnnouncementsV1CreateJSONRequestBody defines body for AnnouncementsV1Create for application/json ContentType.
@@ -828,9 +1612,24 @@ type FCMTokenV1UpsertJSONRequestBody = UserServiceFCMTokenRequest
 // MakeupClassesV1CreateJSONRequestBody defines body for MakeupClassesV1Create for application/json ContentType.
 type MakeupClassesV1CreateJSONRequestBody = AcademicServiceMakeupClassRequest
 
+// NotificationTemplatesV1CreateJSONRequestBody defines body for NotificationTemplatesV1Create for application/json ContentType.
+type NotificationTemplatesV1CreateJSONRequestBody = NotificationTemplateRequest
+
+// NotificationTemplatesV1UpdateJSONRequestBody defines body for NotificationTemplatesV1Update for application/json ContentType.
+type NotificationTemplatesV1UpdateJSONRequestBody = NotificationTemplateRequest
+
+// NotificationTemplatesV1CreateNotificationJSONRequestBody defines body for NotificationTemplatesV1CreateNotification for application/json ContentType.
+type NotificationTemplatesV1CreateNotificationJSONRequestBody = NotificationTemplateRenderRequest
+
 // NotificationV1CreateJSONRequestBody defines body for NotificationV1Create for application/json ContentType.
 type NotificationV1CreateJSONRequestBody = UserServiceNotificationRequest
 
+// NotificationV1CreateForAudienceJSONRequestBody defines body for NotificationV1CreateForAudience for application/json ContentType.
+type NotificationV1CreateForAudienceJSONRequestBody NotificationV1CreateForAudienceJSONBody
+
+// NotificationV1PreviewAudienceJSONRequestBody defines body for NotificationV1PreviewAudience for application/json ContentType.
+type NotificationV1PreviewAudienceJSONRequestBody NotificationV1PreviewAudienceJSONBody
+
 // NotificationV1DispatchJSONRequestBody defines body for NotificationV1Dispatch for application/json ContentType.
 type NotificationV1DispatchJSONRequestBody NotificationV1DispatchJSONBody
 
@@ -859,7 +1658,7 @@ type UsersV1UpsertJSONRequestBody = UserServiceUserRequest
 type ServerInterface interface {
 
 	// (GET /v1/announcements)
-	AnnouncementsV1List(c *gin.Context)
+	AnnouncementsV1List(c *gin.Context, params AnnouncementsV1ListParams)
 
 	// (POST /v1/announcements)
 	AnnouncementsV1Create(c *gin.Context)
@@ -871,13 +1670,19 @@ type ServerInterface interface {
 	AnnouncementsV1Detail(c *gin.Context, id string)
 
 	// (PUT /v1/announcements/{id})
-	AnnouncementsV1Update(c *gin.Context, id string)
+	AnnouncementsV1Update(c *gin.Context, id string, params AnnouncementsV1UpdateParams)
+
+	// (GET /v1/auditLogs)
+	AuditLogsV1List(c *gin.Context, params AuditLogsV1ListParams)
+
+	// (DELETE /v1/cache)
+	CacheV1Flush(c *gin.Context)
 
 	// (GET /v1/cancelledClasses)
 	CancelledClassesV1List(c *gin.Context, params CancelledClassesV1ListParams)
 
 	// (POST /v1/cancelledClasses)
-	CancelledClassesV1Create(c *gin.Context)
+	CancelledClassesV1Create(c *gin.Context, params CancelledClassesV1CreateParams)
 
 	// (DELETE /v1/cancelledClasses/{id})
 	CancelledClassesV1Delete(c *gin.Context, id string)
@@ -904,7 +1709,7 @@ type ServerInterface interface {
 	FacultiesV1Detail(c *gin.Context, id string)
 
 	// (PUT /v1/faculties/{id})
-	FacultiesV1Update(c *gin.Context, id string)
+	FacultiesV1Update(c *gin.Context, id string, params FacultiesV1UpdateParams)
 
 	// (GET /v1/facultyRooms)
 	FacultyRoomsV1List(c *gin.Context, params FacultyRoomsV1ListParams)
@@ -925,7 +1730,7 @@ type ServerInterface interface {
 	MakeupClassesV1List(c *gin.Context, params MakeupClassesV1ListParams)
 
 	// (POST /v1/makeupClasses)
-	MakeupClassesV1Create(c *gin.Context)
+	MakeupClassesV1Create(c *gin.Context, params MakeupClassesV1CreateParams)
 
 	// (DELETE /v1/makeupClasses/{id})
 	MakeupClassesV1Delete(c *gin.Context, id string)
@@ -933,20 +1738,50 @@ type ServerInterface interface {
 	// (GET /v1/menuItems)
 	MenuItemsV1List(c *gin.Context, params MenuItemsV1ListParams)
 
+	// (GET /v1/notificationTemplates)
+	NotificationTemplatesV1List(c *gin.Context)
+
+	// (POST /v1/notificationTemplates)
+	NotificationTemplatesV1Create(c *gin.Context)
+
+	// (DELETE /v1/notificationTemplates/{id})
+	NotificationTemplatesV1Delete(c *gin.Context, id string)
+
+	// (GET /v1/notificationTemplates/{id})
+	NotificationTemplatesV1Detail(c *gin.Context, id string)
+
+	// (PUT /v1/notificationTemplates/{id})
+	NotificationTemplatesV1Update(c *gin.Context, id string)
+
+	// (POST /v1/notificationTemplates/{id}/notifications)
+	NotificationTemplatesV1CreateNotification(c *gin.Context, id string)
+
 	// (GET /v1/notifications)
 	NotificationV1List(c *gin.Context, params NotificationV1ListParams)
 
 	// (POST /v1/notifications)
 	NotificationV1Create(c *gin.Context)
 
+	// (POST /v1/notifications/audience)
+	NotificationV1CreateForAudience(c *gin.Context)
+
+	// (POST /v1/notifications/audience/preview)
+	NotificationV1PreviewAudience(c *gin.Context)
+
 	// (POST /v1/notifications/dispatch)
-	NotificationV1Dispatch(c *gin.Context)
+	NotificationV1Dispatch(c *gin.Context, params NotificationV1DispatchParams)
+
+	// (GET /v1/notifications/lifecycle)
+	NotificationV1Lifecycle(c *gin.Context, params NotificationV1LifecycleParams)
 
 	// (DELETE /v1/notifications/{id})
 	NotificationV1Delete(c *gin.Context, id string)
 
+	// (GET /v1/notifications/{id})
+	NotificationV1Detail(c *gin.Context, id string)
+
 	// (PUT /v1/notifications/{id})
-	NotificationV1Update(c *gin.Context, id string)
+	NotificationV1Update(c *gin.Context, id string, params NotificationV1UpdateParams)
 
 	// (GET /v1/personalCalendarItems)
 	PersonalCalendarItemsV1List(c *gin.Context, params PersonalCalendarItemsV1ListParams)
@@ -964,7 +1799,7 @@ type ServerInterface interface {
 	RoomChangesV1List(c *gin.Context, params RoomChangesV1ListParams)
 
 	// (POST /v1/roomChanges)
-	RoomChangesV1Create(c *gin.Context)
+	RoomChangesV1Create(c *gin.Context, params RoomChangesV1CreateParams)
 
 	// (DELETE /v1/roomChanges/{id})
 	RoomChangesV1Delete(c *gin.Context, id string)
@@ -982,7 +1817,7 @@ type ServerInterface interface {
 	RoomsV1Detail(c *gin.Context, id string)
 
 	// (PUT /v1/rooms/{id})
-	RoomsV1Update(c *gin.Context, id string)
+	RoomsV1Update(c *gin.Context, id string, params RoomsV1UpdateParams)
 
 	// (GET /v1/subjects)
 	SubjectsV1List(c *gin.Context, params SubjectsV1ListParams)
@@ -993,6 +1828,9 @@ type ServerInterface interface {
 	// (GET /v1/subjects/{id})
 	SubjectsV1Detail(c *gin.Context, id string)
 
+	// (GET /v1/subjects/{id}/syllabus)
+	SyllabusV1Detail(c *gin.Context, id string)
+
 	// (GET /v1/timetableItmes)
 	TimetableItemsV1List(c *gin.Context, params TimetableItemsV1ListParams)
 
@@ -1003,7 +1841,7 @@ type ServerInterface interface {
 	TimetableItemsV1Delete(c *gin.Context, id string)
 
 	// (GET /v1/users)
-	UsersV1List(c *gin.Context)
+	UsersV1List(c *gin.Context, params UsersV1ListParams)
 
 	// (GET /v1/users/{id})
 	UsersV1Detail(c *gin.Context, id string)
@@ -1024,8 +1862,61 @@ type MiddlewareFunc func(c *gin.Context)
 // AnnouncementsV1List operation middleware
 func (siw *ServerInterfaceWrapper) AnnouncementsV1List(c *gin.Context) {
 
+	var err error
+
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AnnouncementsV1ListParams
+
+	// ------------- Optional query parameter "sortByDate" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sortByDate", c.Request.URL.Query(), &params.SortByDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sortByDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "filterIsActive" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "filterIsActive", c.Request.URL.Query(), &params.FilterIsActive)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filterIsActive: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "statuses" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "statuses", c.Request.URL.Query(), &params.Statuses)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter statuses: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1033,7 +1924,7 @@ func (siw *ServerInterfaceWrapper) AnnouncementsV1List(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AnnouncementsV1List(c)
+	siw.Handler.AnnouncementsV1List(c, params)
 }
 
 // AnnouncementsV1Create operation middleware
@@ -1119,6 +2010,30 @@ func (siw *ServerInterfaceWrapper) AnnouncementsV1Update(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AnnouncementsV1UpdateParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1126,40 +2041,48 @@ func (siw *ServerInterfaceWrapper) AnnouncementsV1Update(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AnnouncementsV1Update(c, id)
+	siw.Handler.AnnouncementsV1Update(c, id, params)
 }
 
-// CancelledClassesV1List operation middleware
-func (siw *ServerInterfaceWrapper) CancelledClassesV1List(c *gin.Context) {
+// AuditLogsV1List operation middleware
+func (siw *ServerInterfaceWrapper) AuditLogsV1List(c *gin.Context) {
 
 	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CancelledClassesV1ListParams
+	var params AuditLogsV1ListParams
 
-	// ------------- Optional query parameter "subjectIds" -------------
+	// ------------- Optional query parameter "actorUid" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "subjectIds", c.Request.URL.Query(), &params.SubjectIds)
+	err = runtime.BindQueryParameter("form", false, false, "actorUid", c.Request.URL.Query(), &params.ActorUid)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subjectIds: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actorUid: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "from" -------------
+	// ------------- Optional query parameter "operationId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	err = runtime.BindQueryParameter("form", false, false, "operationId", c.Request.URL.Query(), &params.OperationId)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter operationId: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "until" -------------
+	// ------------- Optional query parameter "since" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "until", c.Request.URL.Query(), &params.Until)
+	err = runtime.BindQueryParameter("form", false, false, "since", c.Request.URL.Query(), &params.Since)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter until: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter since: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1170,11 +2093,11 @@ func (siw *ServerInterfaceWrapper) CancelledClassesV1List(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CancelledClassesV1List(c, params)
+	siw.Handler.AuditLogsV1List(c, params)
 }
 
-// CancelledClassesV1Create operation middleware
-func (siw *ServerInterfaceWrapper) CancelledClassesV1Create(c *gin.Context) {
+// CacheV1Flush operation middleware
+func (siw *ServerInterfaceWrapper) CacheV1Flush(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -1185,39 +2108,135 @@ func (siw *ServerInterfaceWrapper) CancelledClassesV1Create(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CancelledClassesV1Create(c)
+	siw.Handler.CacheV1Flush(c)
 }
 
-// CancelledClassesV1Delete operation middleware
-func (siw *ServerInterfaceWrapper) CancelledClassesV1Delete(c *gin.Context) {
+// CancelledClassesV1List operation middleware
+func (siw *ServerInterfaceWrapper) CancelledClassesV1List(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelledClassesV1ListParams
+
+	// ------------- Optional query parameter "subjectIds" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "subjectIds", c.Request.URL.Query(), &params.SubjectIds)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subjectIds: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "from" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.CancelledClassesV1Delete(c, id)
-}
+	// ------------- Optional query parameter "until" -------------
 
-// CourseRegistrationsV1List operation middleware
-func (siw *ServerInterfaceWrapper) CourseRegistrationsV1List(c *gin.Context) {
+	err = runtime.BindQueryParameter("form", true, false, "until", c.Request.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter until: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	var err error
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CancelledClassesV1List(c, params)
+}
+
+// CancelledClassesV1Create operation middleware
+func (siw *ServerInterfaceWrapper) CancelledClassesV1Create(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelledClassesV1CreateParams
+
+	// ------------- Optional query parameter "notify" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "notify", c.Request.URL.Query(), &params.Notify)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter notify: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CancelledClassesV1Create(c, params)
+}
+
+// CancelledClassesV1Delete operation middleware
+func (siw *ServerInterfaceWrapper) CancelledClassesV1Delete(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CancelledClassesV1Delete(c, id)
+}
+
+// CourseRegistrationsV1List operation middleware
+func (siw *ServerInterfaceWrapper) CourseRegistrationsV1List(c *gin.Context) {
+
+	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -1262,6 +2281,30 @@ func (siw *ServerInterfaceWrapper) CourseRegistrationsV1List(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1331,6 +2374,30 @@ func (siw *ServerInterfaceWrapper) FacultiesV1List(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1424,6 +2491,30 @@ func (siw *ServerInterfaceWrapper) FacultiesV1Update(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FacultiesV1UpdateParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1431,7 +2522,7 @@ func (siw *ServerInterfaceWrapper) FacultiesV1Update(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.FacultiesV1Update(c, id)
+	siw.Handler.FacultiesV1Update(c, id, params)
 }
 
 // FacultyRoomsV1List operation middleware
@@ -1452,6 +2543,30 @@ func (siw *ServerInterfaceWrapper) FacultyRoomsV1List(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1545,6 +2660,30 @@ func (siw *ServerInterfaceWrapper) FCMTokenV1List(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1604,6 +2743,30 @@ func (siw *ServerInterfaceWrapper) MakeupClassesV1List(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1617,8 +2780,21 @@ func (siw *ServerInterfaceWrapper) MakeupClassesV1List(c *gin.Context) {
 // MakeupClassesV1Create operation middleware
 func (siw *ServerInterfaceWrapper) MakeupClassesV1Create(c *gin.Context) {
 
+	var err error
+
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MakeupClassesV1CreateParams
+
+	// ------------- Optional query parameter "notify" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "notify", c.Request.URL.Query(), &params.Notify)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter notify: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1626,7 +2802,7 @@ func (siw *ServerInterfaceWrapper) MakeupClassesV1Create(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.MakeupClassesV1Create(c)
+	siw.Handler.MakeupClassesV1Create(c, params)
 }
 
 // MakeupClassesV1Delete operation middleware
@@ -1680,47 +2856,27 @@ func (siw *ServerInterfaceWrapper) MenuItemsV1List(c *gin.Context) {
 		return
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.MenuItemsV1List(c, params)
-}
-
-// NotificationV1List operation middleware
-func (siw *ServerInterfaceWrapper) NotificationV1List(c *gin.Context) {
-
-	var err error
-
-	c.Set(BearerAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params NotificationV1ListParams
-
-	// ------------- Optional query parameter "notifyAtFrom" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "notifyAtFrom", c.Request.URL.Query(), &params.NotifyAtFrom)
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter notifyAtFrom: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "notifyAtTo" -------------
+	// ------------- Optional query parameter "cursor" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "notifyAtTo", c.Request.URL.Query(), &params.NotifyAtTo)
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter notifyAtTo: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "isNotified" -------------
+	// ------------- Optional query parameter "sort" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "isNotified", c.Request.URL.Query(), &params.IsNotified)
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter isNotified: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1731,11 +2887,11 @@ func (siw *ServerInterfaceWrapper) NotificationV1List(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.NotificationV1List(c, params)
+	siw.Handler.MenuItemsV1List(c, params)
 }
 
-// NotificationV1Create operation middleware
-func (siw *ServerInterfaceWrapper) NotificationV1Create(c *gin.Context) {
+// NotificationTemplatesV1List operation middleware
+func (siw *ServerInterfaceWrapper) NotificationTemplatesV1List(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -1746,11 +2902,11 @@ func (siw *ServerInterfaceWrapper) NotificationV1Create(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.NotificationV1Create(c)
+	siw.Handler.NotificationTemplatesV1List(c)
 }
 
-// NotificationV1Dispatch operation middleware
-func (siw *ServerInterfaceWrapper) NotificationV1Dispatch(c *gin.Context) {
+// NotificationTemplatesV1Create operation middleware
+func (siw *ServerInterfaceWrapper) NotificationTemplatesV1Create(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -1761,11 +2917,11 @@ func (siw *ServerInterfaceWrapper) NotificationV1Dispatch(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.NotificationV1Dispatch(c)
+	siw.Handler.NotificationTemplatesV1Create(c)
 }
 
-// NotificationV1Delete operation middleware
-func (siw *ServerInterfaceWrapper) NotificationV1Delete(c *gin.Context) {
+// NotificationTemplatesV1Delete operation middleware
+func (siw *ServerInterfaceWrapper) NotificationTemplatesV1Delete(c *gin.Context) {
 
 	var err error
 
@@ -1787,11 +2943,11 @@ func (siw *ServerInterfaceWrapper) NotificationV1Delete(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.NotificationV1Delete(c, id)
+	siw.Handler.NotificationTemplatesV1Delete(c, id)
 }
 
-// NotificationV1Update operation middleware
-func (siw *ServerInterfaceWrapper) NotificationV1Update(c *gin.Context) {
+// NotificationTemplatesV1Detail operation middleware
+func (siw *ServerInterfaceWrapper) NotificationTemplatesV1Detail(c *gin.Context) {
 
 	var err error
 
@@ -1813,48 +2969,24 @@ func (siw *ServerInterfaceWrapper) NotificationV1Update(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.NotificationV1Update(c, id)
+	siw.Handler.NotificationTemplatesV1Detail(c, id)
 }
 
-// PersonalCalendarItemsV1List operation middleware
-func (siw *ServerInterfaceWrapper) PersonalCalendarItemsV1List(c *gin.Context) {
+// NotificationTemplatesV1Update operation middleware
+func (siw *ServerInterfaceWrapper) NotificationTemplatesV1Update(c *gin.Context) {
 
 	var err error
 
-	c.Set(BearerAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PersonalCalendarItemsV1ListParams
-
-	// ------------- Required query parameter "userId" -------------
-
-	if paramValue := c.Query("userId"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument userId is required, but not found"), http.StatusBadRequest)
-		return
-	}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindQueryParameter("form", false, true, "userId", c.Request.URL.Query(), &params.UserId)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Required query parameter "dates" -------------
-
-	if paramValue := c.Query("dates"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument dates is required, but not found"), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", false, true, "dates", c.Request.URL.Query(), &params.Dates)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dates: %w", err), http.StatusBadRequest)
-		return
-	}
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1863,42 +2995,24 @@ func (siw *ServerInterfaceWrapper) PersonalCalendarItemsV1List(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PersonalCalendarItemsV1List(c, params)
+	siw.Handler.NotificationTemplatesV1Update(c, id)
 }
 
-// ReservationsV1List operation middleware
-func (siw *ServerInterfaceWrapper) ReservationsV1List(c *gin.Context) {
+// NotificationTemplatesV1CreateNotification operation middleware
+func (siw *ServerInterfaceWrapper) NotificationTemplatesV1CreateNotification(c *gin.Context) {
 
 	var err error
 
-	c.Set(BearerAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ReservationsV1ListParams
-
-	// ------------- Optional query parameter "roomIds" -------------
-
-	err = runtime.BindQueryParameter("form", false, false, "roomIds", c.Request.URL.Query(), &params.RoomIds)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter roomIds: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "from" -------------
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "until" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "until", c.Request.URL.Query(), &params.Until)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter until: %w", err), http.StatusBadRequest)
-		return
-	}
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1907,39 +3021,66 @@ func (siw *ServerInterfaceWrapper) ReservationsV1List(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ReservationsV1List(c, params)
+	siw.Handler.NotificationTemplatesV1CreateNotification(c, id)
 }
 
-// ReservationsV1Create operation middleware
-func (siw *ServerInterfaceWrapper) ReservationsV1Create(c *gin.Context) {
+// NotificationV1List operation middleware
+func (siw *ServerInterfaceWrapper) NotificationV1List(c *gin.Context) {
+
+	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotificationV1ListParams
+
+	// ------------- Optional query parameter "notifyAtFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "notifyAtFrom", c.Request.URL.Query(), &params.NotifyAtFrom)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter notifyAtFrom: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.ReservationsV1Create(c)
-}
+	// ------------- Optional query parameter "notifyAtTo" -------------
 
-// ReservationsV1Delete operation middleware
-func (siw *ServerInterfaceWrapper) ReservationsV1Delete(c *gin.Context) {
+	err = runtime.BindQueryParameter("form", true, false, "notifyAtTo", c.Request.URL.Query(), &params.NotifyAtTo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter notifyAtTo: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	var err error
+	// ------------- Optional query parameter "isNotified" -------------
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	err = runtime.BindQueryParameter("form", false, false, "isNotified", c.Request.URL.Query(), &params.IsNotified)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter isNotified: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1948,42 +3089,28 @@ func (siw *ServerInterfaceWrapper) ReservationsV1Delete(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ReservationsV1Delete(c, id)
+	siw.Handler.NotificationV1List(c, params)
 }
 
-// RoomChangesV1List operation middleware
-func (siw *ServerInterfaceWrapper) RoomChangesV1List(c *gin.Context) {
-
-	var err error
+// NotificationV1Create operation middleware
+func (siw *ServerInterfaceWrapper) NotificationV1Create(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params RoomChangesV1ListParams
-
-	// ------------- Optional query parameter "subjectIds" -------------
-
-	err = runtime.BindQueryParameter("form", false, false, "subjectIds", c.Request.URL.Query(), &params.SubjectIds)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subjectIds: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "from" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
-		return
-	}
+	siw.Handler.NotificationV1Create(c)
+}
 
-	// ------------- Optional query parameter "until" -------------
+// NotificationV1CreateForAudience operation middleware
+func (siw *ServerInterfaceWrapper) NotificationV1CreateForAudience(c *gin.Context) {
 
-	err = runtime.BindQueryParameter("form", true, false, "until", c.Request.URL.Query(), &params.Until)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter until: %w", err), http.StatusBadRequest)
-		return
-	}
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1992,11 +3119,11 @@ func (siw *ServerInterfaceWrapper) RoomChangesV1List(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoomChangesV1List(c, params)
+	siw.Handler.NotificationV1CreateForAudience(c)
 }
 
-// RoomChangesV1Create operation middleware
-func (siw *ServerInterfaceWrapper) RoomChangesV1Create(c *gin.Context) {
+// NotificationV1PreviewAudience operation middleware
+func (siw *ServerInterfaceWrapper) NotificationV1PreviewAudience(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -2007,25 +3134,27 @@ func (siw *ServerInterfaceWrapper) RoomChangesV1Create(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoomChangesV1Create(c)
+	siw.Handler.NotificationV1PreviewAudience(c)
 }
 
-// RoomChangesV1Delete operation middleware
-func (siw *ServerInterfaceWrapper) RoomChangesV1Delete(c *gin.Context) {
+// NotificationV1Dispatch operation middleware
+func (siw *ServerInterfaceWrapper) NotificationV1Dispatch(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotificationV1DispatchParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "dryRun", c.Request.URL.Query(), &params.DryRun)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dryRun: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2033,49 +3162,58 @@ func (siw *ServerInterfaceWrapper) RoomChangesV1Delete(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoomChangesV1Delete(c, id)
+	siw.Handler.NotificationV1Dispatch(c, params)
 }
 
-// RoomsV1List operation middleware
-func (siw *ServerInterfaceWrapper) RoomsV1List(c *gin.Context) {
+// NotificationV1Lifecycle operation middleware
+func (siw *ServerInterfaceWrapper) NotificationV1Lifecycle(c *gin.Context) {
 
 	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RoomsV1ListParams
+	var params NotificationV1LifecycleParams
 
-	// ------------- Optional query parameter "q" -------------
+	// ------------- Optional query parameter "notifyAtFrom" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "q", c.Request.URL.Query(), &params.Q)
+	err = runtime.BindQueryParameter("form", true, false, "notifyAtFrom", c.Request.URL.Query(), &params.NotifyAtFrom)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter notifyAtFrom: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "floors" -------------
+	// ------------- Optional query parameter "notifyAtTo" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "floors", c.Request.URL.Query(), &params.Floors)
+	err = runtime.BindQueryParameter("form", true, false, "notifyAtTo", c.Request.URL.Query(), &params.NotifyAtTo)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter floors: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter notifyAtTo: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	// ------------- Optional query parameter "statuses" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "statuses", c.Request.URL.Query(), &params.Statuses)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter statuses: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.RoomsV1List(c, params)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-// RoomsV1Create operation middleware
-func (siw *ServerInterfaceWrapper) RoomsV1Create(c *gin.Context) {
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	c.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -2084,11 +3222,11 @@ func (siw *ServerInterfaceWrapper) RoomsV1Create(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoomsV1Create(c)
+	siw.Handler.NotificationV1Lifecycle(c, params)
 }
 
-// RoomsV1Delete operation middleware
-func (siw *ServerInterfaceWrapper) RoomsV1Delete(c *gin.Context) {
+// NotificationV1Delete operation middleware
+func (siw *ServerInterfaceWrapper) NotificationV1Delete(c *gin.Context) {
 
 	var err error
 
@@ -2110,11 +3248,11 @@ func (siw *ServerInterfaceWrapper) RoomsV1Delete(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoomsV1Delete(c, id)
+	siw.Handler.NotificationV1Delete(c, id)
 }
 
-// RoomsV1Detail operation middleware
-func (siw *ServerInterfaceWrapper) RoomsV1Detail(c *gin.Context) {
+// NotificationV1Detail operation middleware
+func (siw *ServerInterfaceWrapper) NotificationV1Detail(c *gin.Context) {
 
 	var err error
 
@@ -2136,11 +3274,11 @@ func (siw *ServerInterfaceWrapper) RoomsV1Detail(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoomsV1Detail(c, id)
+	siw.Handler.NotificationV1Detail(c, id)
 }
 
-// RoomsV1Update operation middleware
-func (siw *ServerInterfaceWrapper) RoomsV1Update(c *gin.Context) {
+// NotificationV1Update operation middleware
+func (siw *ServerInterfaceWrapper) NotificationV1Update(c *gin.Context) {
 
 	var err error
 
@@ -2155,6 +3293,30 @@ func (siw *ServerInterfaceWrapper) RoomsV1Update(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotificationV1UpdateParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2162,88 +3324,70 @@ func (siw *ServerInterfaceWrapper) RoomsV1Update(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RoomsV1Update(c, id)
+	siw.Handler.NotificationV1Update(c, id, params)
 }
 
-// SubjectsV1List operation middleware
-func (siw *ServerInterfaceWrapper) SubjectsV1List(c *gin.Context) {
+// PersonalCalendarItemsV1List operation middleware
+func (siw *ServerInterfaceWrapper) PersonalCalendarItemsV1List(c *gin.Context) {
 
 	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SubjectsV1ListParams
-
-	// ------------- Optional query parameter "q" -------------
+	var params PersonalCalendarItemsV1ListParams
 
-	err = runtime.BindQueryParameter("form", false, false, "q", c.Request.URL.Query(), &params.Q)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
-		return
-	}
+	// ------------- Required query parameter "userId" -------------
 
-	// ------------- Optional query parameter "grades" -------------
+	if paramValue := c.Query("userId"); paramValue != "" {
 
-	err = runtime.BindQueryParameter("form", false, false, "grades", c.Request.URL.Query(), &params.Grades)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter grades: %w", err), http.StatusBadRequest)
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument userId is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "courses" -------------
-
-	err = runtime.BindQueryParameter("form", false, false, "courses", c.Request.URL.Query(), &params.Courses)
+	err = runtime.BindQueryParameter("form", false, true, "userId", c.Request.URL.Query(), &params.UserId)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter courses: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "classes" -------------
-
-	err = runtime.BindQueryParameter("form", false, false, "classes", c.Request.URL.Query(), &params.Classes)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter classes: %w", err), http.StatusBadRequest)
-		return
-	}
+	// ------------- Required query parameter "dates" -------------
 
-	// ------------- Optional query parameter "classifications" -------------
+	if paramValue := c.Query("dates"); paramValue != "" {
 
-	err = runtime.BindQueryParameter("form", false, false, "classifications", c.Request.URL.Query(), &params.Classifications)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter classifications: %w", err), http.StatusBadRequest)
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument dates is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "year" -------------
-
-	err = runtime.BindQueryParameter("form", false, false, "year", c.Request.URL.Query(), &params.Year)
+	err = runtime.BindQueryParameter("form", false, true, "dates", c.Request.URL.Query(), &params.Dates)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter year: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dates: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "semesters" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "semesters", c.Request.URL.Query(), &params.Semesters)
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter semesters: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "requirementTypes" -------------
+	// ------------- Optional query parameter "cursor" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "requirementTypes", c.Request.URL.Query(), &params.RequirementTypes)
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter requirementTypes: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "culturalSubjectCategories" -------------
+	// ------------- Optional query parameter "sort" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "culturalSubjectCategories", c.Request.URL.Query(), &params.CulturalSubjectCategories)
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter culturalSubjectCategories: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2254,23 +3398,80 @@ func (siw *ServerInterfaceWrapper) SubjectsV1List(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SubjectsV1List(c, params)
+	siw.Handler.PersonalCalendarItemsV1List(c, params)
 }
 
-// SubjectsV1Delete operation middleware
-func (siw *ServerInterfaceWrapper) SubjectsV1Delete(c *gin.Context) {
+// ReservationsV1List operation middleware
+func (siw *ServerInterfaceWrapper) ReservationsV1List(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	c.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReservationsV1ListParams
+
+	// ------------- Optional query parameter "roomIds" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "roomIds", c.Request.URL.Query(), &params.RoomIds)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter roomIds: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", c.Request.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter until: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReservationsV1List(c, params)
+}
+
+// ReservationsV1Create operation middleware
+func (siw *ServerInterfaceWrapper) ReservationsV1Create(c *gin.Context) {
+
 	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2280,11 +3481,11 @@ func (siw *ServerInterfaceWrapper) SubjectsV1Delete(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SubjectsV1Delete(c, id)
+	siw.Handler.ReservationsV1Create(c)
 }
 
-// SubjectsV1Detail operation middleware
-func (siw *ServerInterfaceWrapper) SubjectsV1Detail(c *gin.Context) {
+// ReservationsV1Delete operation middleware
+func (siw *ServerInterfaceWrapper) ReservationsV1Delete(c *gin.Context) {
 
 	var err error
 
@@ -2306,39 +3507,64 @@ func (siw *ServerInterfaceWrapper) SubjectsV1Detail(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SubjectsV1Detail(c, id)
+	siw.Handler.ReservationsV1Delete(c, id)
 }
 
-// TimetableItemsV1List operation middleware
-func (siw *ServerInterfaceWrapper) TimetableItemsV1List(c *gin.Context) {
+// RoomChangesV1List operation middleware
+func (siw *ServerInterfaceWrapper) RoomChangesV1List(c *gin.Context) {
 
 	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TimetableItemsV1ListParams
+	var params RoomChangesV1ListParams
 
-	// ------------- Optional query parameter "year" -------------
+	// ------------- Optional query parameter "subjectIds" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "year", c.Request.URL.Query(), &params.Year)
+	err = runtime.BindQueryParameter("form", false, false, "subjectIds", c.Request.URL.Query(), &params.SubjectIds)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter year: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter subjectIds: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Required query parameter "semesters" -------------
+	// ------------- Optional query parameter "from" -------------
 
-	if paramValue := c.Query("semesters"); paramValue != "" {
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument semesters is required, but not found"), http.StatusBadRequest)
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", c.Request.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter until: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", false, true, "semesters", c.Request.URL.Query(), &params.Semesters)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter semesters: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2349,14 +3575,27 @@ func (siw *ServerInterfaceWrapper) TimetableItemsV1List(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.TimetableItemsV1List(c, params)
+	siw.Handler.RoomChangesV1List(c, params)
 }
 
-// TimetableItemsV1Create operation middleware
-func (siw *ServerInterfaceWrapper) TimetableItemsV1Create(c *gin.Context) {
+// RoomChangesV1Create operation middleware
+func (siw *ServerInterfaceWrapper) RoomChangesV1Create(c *gin.Context) {
+
+	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RoomChangesV1CreateParams
+
+	// ------------- Optional query parameter "notify" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "notify", c.Request.URL.Query(), &params.Notify)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter notify: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2364,11 +3603,11 @@ func (siw *ServerInterfaceWrapper) TimetableItemsV1Create(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.TimetableItemsV1Create(c)
+	siw.Handler.RoomChangesV1Create(c, params)
 }
 
-// TimetableItemsV1Delete operation middleware
-func (siw *ServerInterfaceWrapper) TimetableItemsV1Delete(c *gin.Context) {
+// RoomChangesV1Delete operation middleware
+func (siw *ServerInterfaceWrapper) RoomChangesV1Delete(c *gin.Context) {
 
 	var err error
 
@@ -2390,11 +3629,71 @@ func (siw *ServerInterfaceWrapper) TimetableItemsV1Delete(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.TimetableItemsV1Delete(c, id)
+	siw.Handler.RoomChangesV1Delete(c, id)
 }
 
-// UsersV1List operation middleware
-func (siw *ServerInterfaceWrapper) UsersV1List(c *gin.Context) {
+// RoomsV1List operation middleware
+func (siw *ServerInterfaceWrapper) RoomsV1List(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RoomsV1ListParams
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "floors" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "floors", c.Request.URL.Query(), &params.Floors)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter floors: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RoomsV1List(c, params)
+}
+
+// RoomsV1Create operation middleware
+func (siw *ServerInterfaceWrapper) RoomsV1Create(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -2405,11 +3704,11 @@ func (siw *ServerInterfaceWrapper) UsersV1List(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UsersV1List(c)
+	siw.Handler.RoomsV1Create(c)
 }
 
-// UsersV1Detail operation middleware
-func (siw *ServerInterfaceWrapper) UsersV1Detail(c *gin.Context) {
+// RoomsV1Delete operation middleware
+func (siw *ServerInterfaceWrapper) RoomsV1Delete(c *gin.Context) {
 
 	var err error
 
@@ -2431,11 +3730,11 @@ func (siw *ServerInterfaceWrapper) UsersV1Detail(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UsersV1Detail(c, id)
+	siw.Handler.RoomsV1Delete(c, id)
 }
 
-// UsersV1Upsert operation middleware
-func (siw *ServerInterfaceWrapper) UsersV1Upsert(c *gin.Context) {
+// RoomsV1Detail operation middleware
+func (siw *ServerInterfaceWrapper) RoomsV1Detail(c *gin.Context) {
 
 	var err error
 
@@ -2457,1822 +3756,5297 @@ func (siw *ServerInterfaceWrapper) UsersV1Upsert(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UsersV1Upsert(c, id)
+	siw.Handler.RoomsV1Detail(c, id)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// RoomsV1Update operation middleware
+func (siw *ServerInterfaceWrapper) RoomsV1Update(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RoomsV1UpdateParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
 		}
+
+		params.IfMatch = &IfMatch
+
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	router.GET(options.BaseURL+"/v1/announcements", wrapper.AnnouncementsV1List)
-	router.POST(options.BaseURL+"/v1/announcements", wrapper.AnnouncementsV1Create)
-	router.DELETE(options.BaseURL+"/v1/announcements/:id", wrapper.AnnouncementsV1Delete)
-	router.GET(options.BaseURL+"/v1/announcements/:id", wrapper.AnnouncementsV1Detail)
-	router.PUT(options.BaseURL+"/v1/announcements/:id", wrapper.AnnouncementsV1Update)
-	router.GET(options.BaseURL+"/v1/cancelledClasses", wrapper.CancelledClassesV1List)
-	router.POST(options.BaseURL+"/v1/cancelledClasses", wrapper.CancelledClassesV1Create)
-	router.DELETE(options.BaseURL+"/v1/cancelledClasses/:id", wrapper.CancelledClassesV1Delete)
-	router.GET(options.BaseURL+"/v1/courseRegistrations", wrapper.CourseRegistrationsV1List)
-	router.POST(options.BaseURL+"/v1/courseRegistrations", wrapper.CourseRegistrationsV1Create)
-	router.DELETE(options.BaseURL+"/v1/courseRegistrations/:id", wrapper.CourseRegistrationsV1Delete)
-	router.GET(options.BaseURL+"/v1/faculties", wrapper.FacultiesV1List)
-	router.POST(options.BaseURL+"/v1/faculties", wrapper.FacultiesV1Create)
-	router.DELETE(options.BaseURL+"/v1/faculties/:id", wrapper.FacultiesV1Delete)
-	router.GET(options.BaseURL+"/v1/faculties/:id", wrapper.FacultiesV1Detail)
-	router.PUT(options.BaseURL+"/v1/faculties/:id", wrapper.FacultiesV1Update)
-	router.GET(options.BaseURL+"/v1/facultyRooms", wrapper.FacultyRoomsV1List)
-	router.POST(options.BaseURL+"/v1/facultyRooms", wrapper.FacultyRoomsV1Create)
-	router.DELETE(options.BaseURL+"/v1/facultyRooms/:id", wrapper.FacultyRoomsV1Delete)
-	router.GET(options.BaseURL+"/v1/fcmTokens", wrapper.FCMTokenV1List)
-	router.POST(options.BaseURL+"/v1/fcmTokens", wrapper.FCMTokenV1Upsert)
-	router.GET(options.BaseURL+"/v1/makeupClasses", wrapper.MakeupClassesV1List)
-	router.POST(options.BaseURL+"/v1/makeupClasses", wrapper.MakeupClassesV1Create)
-	router.DELETE(options.BaseURL+"/v1/makeupClasses/:id", wrapper.MakeupClassesV1Delete)
-	router.GET(options.BaseURL+"/v1/menuItems", wrapper.MenuItemsV1List)
-	router.GET(options.BaseURL+"/v1/notifications", wrapper.NotificationV1List)
-	router.POST(options.BaseURL+"/v1/notifications", wrapper.NotificationV1Create)
-	router.POST(options.BaseURL+"/v1/notifications/dispatch", wrapper.NotificationV1Dispatch)
-	router.DELETE(options.BaseURL+"/v1/notifications/:id", wrapper.NotificationV1Delete)
-	router.PUT(options.BaseURL+"/v1/notifications/:id", wrapper.NotificationV1Update)
-	router.GET(options.BaseURL+"/v1/personalCalendarItems", wrapper.PersonalCalendarItemsV1List)
-	router.GET(options.BaseURL+"/v1/reservations", wrapper.ReservationsV1List)
-	router.POST(options.BaseURL+"/v1/reservations", wrapper.ReservationsV1Create)
-	router.DELETE(options.BaseURL+"/v1/reservations/:id", wrapper.ReservationsV1Delete)
-	router.GET(options.BaseURL+"/v1/roomChanges", wrapper.RoomChangesV1List)
-	router.POST(options.BaseURL+"/v1/roomChanges", wrapper.RoomChangesV1Create)
-	router.DELETE(options.BaseURL+"/v1/roomChanges/:id", wrapper.RoomChangesV1Delete)
-	router.GET(options.BaseURL+"/v1/rooms", wrapper.RoomsV1List)
-	router.POST(options.BaseURL+"/v1/rooms", wrapper.RoomsV1Create)
-	router.DELETE(options.BaseURL+"/v1/rooms/:id", wrapper.RoomsV1Delete)
-	router.GET(options.BaseURL+"/v1/rooms/:id", wrapper.RoomsV1Detail)
-	router.PUT(options.BaseURL+"/v1/rooms/:id", wrapper.RoomsV1Update)
-	router.GET(options.BaseURL+"/v1/subjects", wrapper.SubjectsV1List)
-	router.DELETE(options.BaseURL+"/v1/subjects/:id", wrapper.SubjectsV1Delete)
-	router.GET(options.BaseURL+"/v1/subjects/:id", wrapper.SubjectsV1Detail)
-	router.GET(options.BaseURL+"/v1/timetableItmes", wrapper.TimetableItemsV1List)
-	router.POST(options.BaseURL+"/v1/timetableItmes", wrapper.TimetableItemsV1Create)
-	router.DELETE(options.BaseURL+"/v1/timetableItmes/:id", wrapper.TimetableItemsV1Delete)
-	router.GET(options.BaseURL+"/v1/users", wrapper.UsersV1List)
-	router.GET(options.BaseURL+"/v1/users/:id", wrapper.UsersV1Detail)
-	router.POST(options.BaseURL+"/v1/users/:id", wrapper.UsersV1Upsert)
+	siw.Handler.RoomsV1Update(c, id, params)
 }
 
-type AnnouncementsV1ListRequestObject struct {
-}
+// SubjectsV1List operation middleware
+func (siw *ServerInterfaceWrapper) SubjectsV1List(c *gin.Context) {
 
-type AnnouncementsV1ListResponseObject interface {
-	VisitAnnouncementsV1ListResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type AnnouncementsV1List200JSONResponse struct {
-	Announcements []AnnouncementServiceAnnouncement `json:"announcements"`
-}
+	c.Set(BearerAuthScopes, []string{})
 
-func (response AnnouncementsV1List200JSONResponse) VisitAnnouncementsV1ListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubjectsV1ListParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "q" -------------
 
-type AnnouncementsV1List401Response struct {
-}
+	err = runtime.BindQueryParameter("form", false, false, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response AnnouncementsV1List401Response) VisitAnnouncementsV1ListResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	// ------------- Optional query parameter "grades" -------------
 
-type AnnouncementsV1CreateRequestObject struct {
-	Body *AnnouncementsV1CreateJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", false, false, "grades", c.Request.URL.Query(), &params.Grades)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter grades: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AnnouncementsV1CreateResponseObject interface {
-	VisitAnnouncementsV1CreateResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "courses" -------------
 
-type AnnouncementsV1Create201JSONResponse struct {
-	Announcement AnnouncementServiceAnnouncement `json:"announcement"`
-}
+	err = runtime.BindQueryParameter("form", false, false, "courses", c.Request.URL.Query(), &params.Courses)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter courses: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response AnnouncementsV1Create201JSONResponse) VisitAnnouncementsV1CreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Optional query parameter "classes" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", false, false, "classes", c.Request.URL.Query(), &params.Classes)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter classes: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AnnouncementsV1Create401Response struct {
-}
+	// ------------- Optional query parameter "classifications" -------------
 
-func (response AnnouncementsV1Create401Response) VisitAnnouncementsV1CreateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", false, false, "classifications", c.Request.URL.Query(), &params.Classifications)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter classifications: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AnnouncementsV1DeleteRequestObject struct {
-	Id string `json:"id"`
-}
+	// ------------- Optional query parameter "year" -------------
 
-type AnnouncementsV1DeleteResponseObject interface {
-	VisitAnnouncementsV1DeleteResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", false, false, "year", c.Request.URL.Query(), &params.Year)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter year: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AnnouncementsV1Delete204Response struct {
-}
+	// ------------- Optional query parameter "semesters" -------------
 
-func (response AnnouncementsV1Delete204Response) VisitAnnouncementsV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", false, false, "semesters", c.Request.URL.Query(), &params.Semesters)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter semesters: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AnnouncementsV1Delete401Response struct {
-}
+	// ------------- Optional query parameter "requirementTypes" -------------
 
-func (response AnnouncementsV1Delete401Response) VisitAnnouncementsV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", false, false, "requirementTypes", c.Request.URL.Query(), &params.RequirementTypes)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter requirementTypes: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AnnouncementsV1Delete404Response struct {
-}
+	// ------------- Optional query parameter "culturalSubjectCategories" -------------
 
-func (response AnnouncementsV1Delete404Response) VisitAnnouncementsV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", false, false, "culturalSubjectCategories", c.Request.URL.Query(), &params.CulturalSubjectCategories)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter culturalSubjectCategories: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AnnouncementsV1DetailRequestObject struct {
-	Id string `json:"id"`
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type AnnouncementsV1DetailResponseObject interface {
-	VisitAnnouncementsV1DetailResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AnnouncementsV1Detail200JSONResponse struct {
-	Announcement AnnouncementServiceAnnouncement `json:"announcement"`
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-func (response AnnouncementsV1Detail200JSONResponse) VisitAnnouncementsV1DetailResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "sort" -------------
 
-type AnnouncementsV1Detail401Response struct {
-}
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response AnnouncementsV1Detail401Response) VisitAnnouncementsV1DetailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type AnnouncementsV1Detail404Response struct {
+	siw.Handler.SubjectsV1List(c, params)
 }
 
-func (response AnnouncementsV1Detail404Response) VisitAnnouncementsV1DetailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+// SubjectsV1Delete operation middleware
+func (siw *ServerInterfaceWrapper) SubjectsV1Delete(c *gin.Context) {
 
-type AnnouncementsV1UpdateRequestObject struct {
-	Id   string `json:"id"`
-	Body *AnnouncementsV1UpdateJSONRequestBody
-}
+	var err error
 
-type AnnouncementsV1UpdateResponseObject interface {
-	VisitAnnouncementsV1UpdateResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type AnnouncementsV1Update200JSONResponse struct {
-	Announcement AnnouncementServiceAnnouncement `json:"announcement"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response AnnouncementsV1Update200JSONResponse) VisitAnnouncementsV1UpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	c.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type AnnouncementsV1Update401Response struct {
+	siw.Handler.SubjectsV1Delete(c, id)
 }
 
-func (response AnnouncementsV1Update401Response) VisitAnnouncementsV1UpdateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+// SubjectsV1Detail operation middleware
+func (siw *ServerInterfaceWrapper) SubjectsV1Detail(c *gin.Context) {
 
-type AnnouncementsV1Update404Response struct {
-}
+	var err error
 
-func (response AnnouncementsV1Update404Response) VisitAnnouncementsV1UpdateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type CancelledClassesV1ListRequestObject struct {
-	Params CancelledClassesV1ListParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CancelledClassesV1ListResponseObject interface {
-	VisitCancelledClassesV1ListResponse(w http.ResponseWriter) error
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SubjectsV1Detail(c, id)
 }
 
-type CancelledClassesV1List200JSONResponse struct {
-	CancelledClasses []AcademicServiceCancelledClass `json:"cancelledClasses"`
-}
-
-func (response CancelledClassesV1List200JSONResponse) VisitCancelledClassesV1ListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// SyllabusV1Detail operation middleware
+func (siw *ServerInterfaceWrapper) SyllabusV1Detail(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type CancelledClassesV1List401Response struct {
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response CancelledClassesV1List401Response) VisitCancelledClassesV1ListResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CancelledClassesV1CreateRequestObject struct {
-	Body *CancelledClassesV1CreateJSONRequestBody
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type CancelledClassesV1CreateResponseObject interface {
-	VisitCancelledClassesV1CreateResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type CancelledClassesV1Create201JSONResponse struct {
-	// CancelledClass 休講
-	CancelledClass AcademicServiceCancelledClass `json:"cancelledClass"`
+	siw.Handler.SyllabusV1Detail(c, id)
 }
 
-func (response CancelledClassesV1Create201JSONResponse) VisitCancelledClassesV1CreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// TimetableItemsV1List operation middleware
+func (siw *ServerInterfaceWrapper) TimetableItemsV1List(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type CancelledClassesV1Create401Response struct {
-}
+	c.Set(BearerAuthScopes, []string{})
 
-func (response CancelledClassesV1Create401Response) VisitCancelledClassesV1CreateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TimetableItemsV1ListParams
 
-type CancelledClassesV1DeleteRequestObject struct {
-	Id string `json:"id"`
-}
+	// ------------- Optional query parameter "year" -------------
 
-type CancelledClassesV1DeleteResponseObject interface {
-	VisitCancelledClassesV1DeleteResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", false, false, "year", c.Request.URL.Query(), &params.Year)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter year: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CancelledClassesV1Delete204Response struct {
-}
+	// ------------- Required query parameter "semesters" -------------
 
-func (response CancelledClassesV1Delete204Response) VisitCancelledClassesV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	if paramValue := c.Query("semesters"); paramValue != "" {
 
-type CancelledClassesV1Delete401Response struct {
-}
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument semesters is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-func (response CancelledClassesV1Delete401Response) VisitCancelledClassesV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", false, true, "semesters", c.Request.URL.Query(), &params.Semesters)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter semesters: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CancelledClassesV1Delete404Response struct {
-}
+	// ------------- Optional query parameter "limit" -------------
 
-func (response CancelledClassesV1Delete404Response) VisitCancelledClassesV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CourseRegistrationsV1ListRequestObject struct {
-	Params CourseRegistrationsV1ListParams
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-type CourseRegistrationsV1ListResponseObject interface {
-	VisitCourseRegistrationsV1ListResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CourseRegistrationsV1List200JSONResponse struct {
-	Registrations []AcademicServiceCourseRegistration `json:"registrations"`
-}
+	// ------------- Optional query parameter "sort" -------------
 
-func (response CourseRegistrationsV1List200JSONResponse) VisitCourseRegistrationsV1ListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type CourseRegistrationsV1List401Response struct {
+	siw.Handler.TimetableItemsV1List(c, params)
 }
 
-func (response CourseRegistrationsV1List401Response) VisitCourseRegistrationsV1ListResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+// TimetableItemsV1Create operation middleware
+func (siw *ServerInterfaceWrapper) TimetableItemsV1Create(c *gin.Context) {
 
-type CourseRegistrationsV1CreateRequestObject struct {
-	Body *CourseRegistrationsV1CreateJSONRequestBody
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type CourseRegistrationsV1CreateResponseObject interface {
-	VisitCourseRegistrationsV1CreateResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type CourseRegistrationsV1Create201JSONResponse struct {
-	Registration AcademicServiceCourseRegistration `json:"registration"`
+	siw.Handler.TimetableItemsV1Create(c)
 }
 
-func (response CourseRegistrationsV1Create201JSONResponse) VisitCourseRegistrationsV1CreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// TimetableItemsV1Delete operation middleware
+func (siw *ServerInterfaceWrapper) TimetableItemsV1Delete(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type CourseRegistrationsV1Create401Response struct {
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response CourseRegistrationsV1Create401Response) VisitCourseRegistrationsV1CreateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CourseRegistrationsV1DeleteRequestObject struct {
-	Id string `json:"id"`
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type CourseRegistrationsV1DeleteResponseObject interface {
-	VisitCourseRegistrationsV1DeleteResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type CourseRegistrationsV1Delete204Response struct {
+	siw.Handler.TimetableItemsV1Delete(c, id)
 }
 
-func (response CourseRegistrationsV1Delete204Response) VisitCourseRegistrationsV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// UsersV1List operation middleware
+func (siw *ServerInterfaceWrapper) UsersV1List(c *gin.Context) {
 
-type CourseRegistrationsV1Delete401Response struct {
-}
+	var err error
 
-func (response CourseRegistrationsV1Delete401Response) VisitCourseRegistrationsV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type CourseRegistrationsV1Delete404Response struct {
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UsersV1ListParams
 
-func (response CourseRegistrationsV1Delete404Response) VisitCourseRegistrationsV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	// ------------- Optional query parameter "grades" -------------
 
-type FacultiesV1ListRequestObject struct {
-	Params FacultiesV1ListParams
-}
+	err = runtime.BindQueryParameter("form", false, false, "grades", c.Request.URL.Query(), &params.Grades)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter grades: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type FacultiesV1ListResponseObject interface {
-	VisitFacultiesV1ListResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "courses" -------------
 
-type FacultiesV1List200JSONResponse struct {
-	Faculties []AcademicServiceFaculty `json:"faculties"`
-}
+	err = runtime.BindQueryParameter("form", false, false, "courses", c.Request.URL.Query(), &params.Courses)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter courses: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response FacultiesV1List200JSONResponse) VisitFacultiesV1ListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "classes" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", false, false, "classes", c.Request.URL.Query(), &params.Classes)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter classes: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type FacultiesV1List401Response struct {
-}
+	// ------------- Optional query parameter "email" -------------
 
-func (response FacultiesV1List401Response) VisitFacultiesV1ListResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", false, false, "email", c.Request.URL.Query(), &params.Email)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter email: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type FacultiesV1CreateRequestObject struct {
-	Body *FacultiesV1CreateJSONRequestBody
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type FacultiesV1CreateResponseObject interface {
-	VisitFacultiesV1CreateResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", false, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type FacultiesV1Create201JSONResponse struct {
-	Faculty AcademicServiceFaculty `json:"faculty"`
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-func (response FacultiesV1Create201JSONResponse) VisitFacultiesV1CreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindQueryParameter("form", false, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "sort" -------------
 
-type FacultiesV1Create401Response struct {
-}
+	err = runtime.BindQueryParameter("form", false, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response FacultiesV1Create401Response) VisitFacultiesV1CreateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type FacultiesV1DeleteRequestObject struct {
-	Id string `json:"id"`
+	siw.Handler.UsersV1List(c, params)
 }
 
-type FacultiesV1DeleteResponseObject interface {
-	VisitFacultiesV1DeleteResponse(w http.ResponseWriter) error
-}
+// UsersV1Detail operation middleware
+func (siw *ServerInterfaceWrapper) UsersV1Detail(c *gin.Context) {
 
-type FacultiesV1Delete204Response struct {
-}
+	var err error
 
-func (response FacultiesV1Delete204Response) VisitFacultiesV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type FacultiesV1Delete401Response struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response FacultiesV1Delete401Response) VisitFacultiesV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type FacultiesV1Delete404Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response FacultiesV1Delete404Response) VisitFacultiesV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+	siw.Handler.UsersV1Detail(c, id)
 }
 
-type FacultiesV1Delete409Response struct {
-}
+// UsersV1Upsert operation middleware
+func (siw *ServerInterfaceWrapper) UsersV1Upsert(c *gin.Context) {
 
-func (response FacultiesV1Delete409Response) VisitFacultiesV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(409)
-	return nil
-}
+	var err error
 
-type FacultiesV1DetailRequestObject struct {
-	Id string `json:"id"`
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type FacultiesV1DetailResponseObject interface {
-	VisitFacultiesV1DetailResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type FacultiesV1Detail200JSONResponse struct {
-	Faculty AcademicServiceFaculty `json:"faculty"`
-}
+	c.Set(BearerAuthScopes, []string{})
 
-func (response FacultiesV1Detail200JSONResponse) VisitFacultiesV1DetailResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.UsersV1Upsert(c, id)
 }
 
-type FacultiesV1Detail401Response struct {
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
 }
 
-func (response FacultiesV1Detail401Response) VisitFacultiesV1DetailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
 }
 
-type FacultiesV1Detail404Response struct {
-}
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
 
-func (response FacultiesV1Detail404Response) VisitFacultiesV1DetailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/v1/announcements", wrapper.AnnouncementsV1List)
+	router.POST(options.BaseURL+"/v1/announcements", wrapper.AnnouncementsV1Create)
+	router.DELETE(options.BaseURL+"/v1/announcements/:id", wrapper.AnnouncementsV1Delete)
+	router.GET(options.BaseURL+"/v1/announcements/:id", wrapper.AnnouncementsV1Detail)
+	router.PUT(options.BaseURL+"/v1/announcements/:id", wrapper.AnnouncementsV1Update)
+	router.GET(options.BaseURL+"/v1/auditLogs", wrapper.AuditLogsV1List)
+	router.DELETE(options.BaseURL+"/v1/cache", wrapper.CacheV1Flush)
+	router.GET(options.BaseURL+"/v1/cancelledClasses", wrapper.CancelledClassesV1List)
+	router.POST(options.BaseURL+"/v1/cancelledClasses", wrapper.CancelledClassesV1Create)
+	router.DELETE(options.BaseURL+"/v1/cancelledClasses/:id", wrapper.CancelledClassesV1Delete)
+	router.GET(options.BaseURL+"/v1/courseRegistrations", wrapper.CourseRegistrationsV1List)
+	router.POST(options.BaseURL+"/v1/courseRegistrations", wrapper.CourseRegistrationsV1Create)
+	router.DELETE(options.BaseURL+"/v1/courseRegistrations/:id", wrapper.CourseRegistrationsV1Delete)
+	router.GET(options.BaseURL+"/v1/faculties", wrapper.FacultiesV1List)
+	router.POST(options.BaseURL+"/v1/faculties", wrapper.FacultiesV1Create)
+	router.DELETE(options.BaseURL+"/v1/faculties/:id", wrapper.FacultiesV1Delete)
+	router.GET(options.BaseURL+"/v1/faculties/:id", wrapper.FacultiesV1Detail)
+	router.PUT(options.BaseURL+"/v1/faculties/:id", wrapper.FacultiesV1Update)
+	router.GET(options.BaseURL+"/v1/facultyRooms", wrapper.FacultyRoomsV1List)
+	router.POST(options.BaseURL+"/v1/facultyRooms", wrapper.FacultyRoomsV1Create)
+	router.DELETE(options.BaseURL+"/v1/facultyRooms/:id", wrapper.FacultyRoomsV1Delete)
+	router.GET(options.BaseURL+"/v1/fcmTokens", wrapper.FCMTokenV1List)
+	router.POST(options.BaseURL+"/v1/fcmTokens", wrapper.FCMTokenV1Upsert)
+	router.GET(options.BaseURL+"/v1/makeupClasses", wrapper.MakeupClassesV1List)
+	router.POST(options.BaseURL+"/v1/makeupClasses", wrapper.MakeupClassesV1Create)
+	router.DELETE(options.BaseURL+"/v1/makeupClasses/:id", wrapper.MakeupClassesV1Delete)
+	router.GET(options.BaseURL+"/v1/menuItems", wrapper.MenuItemsV1List)
+	router.GET(options.BaseURL+"/v1/notificationTemplates", wrapper.NotificationTemplatesV1List)
+	router.POST(options.BaseURL+"/v1/notificationTemplates", wrapper.NotificationTemplatesV1Create)
+	router.DELETE(options.BaseURL+"/v1/notificationTemplates/:id", wrapper.NotificationTemplatesV1Delete)
+	router.GET(options.BaseURL+"/v1/notificationTemplates/:id", wrapper.NotificationTemplatesV1Detail)
+	router.PUT(options.BaseURL+"/v1/notificationTemplates/:id", wrapper.NotificationTemplatesV1Update)
+	router.POST(options.BaseURL+"/v1/notificationTemplates/:id/notifications", wrapper.NotificationTemplatesV1CreateNotification)
+	router.GET(options.BaseURL+"/v1/notifications", wrapper.NotificationV1List)
+	router.POST(options.BaseURL+"/v1/notifications", wrapper.NotificationV1Create)
+	router.POST(options.BaseURL+"/v1/notifications/audience", wrapper.NotificationV1CreateForAudience)
+	router.POST(options.BaseURL+"/v1/notifications/audience/preview", wrapper.NotificationV1PreviewAudience)
+	router.POST(options.BaseURL+"/v1/notifications/dispatch", wrapper.NotificationV1Dispatch)
+	router.GET(options.BaseURL+"/v1/notifications/lifecycle", wrapper.NotificationV1Lifecycle)
+	router.DELETE(options.BaseURL+"/v1/notifications/:id", wrapper.NotificationV1Delete)
+	router.GET(options.BaseURL+"/v1/notifications/:id", wrapper.NotificationV1Detail)
+	router.PUT(options.BaseURL+"/v1/notifications/:id", wrapper.NotificationV1Update)
+	router.GET(options.BaseURL+"/v1/personalCalendarItems", wrapper.PersonalCalendarItemsV1List)
+	router.GET(options.BaseURL+"/v1/reservations", wrapper.ReservationsV1List)
+	router.POST(options.BaseURL+"/v1/reservations", wrapper.ReservationsV1Create)
+	router.DELETE(options.BaseURL+"/v1/reservations/:id", wrapper.ReservationsV1Delete)
+	router.GET(options.BaseURL+"/v1/roomChanges", wrapper.RoomChangesV1List)
+	router.POST(options.BaseURL+"/v1/roomChanges", wrapper.RoomChangesV1Create)
+	router.DELETE(options.BaseURL+"/v1/roomChanges/:id", wrapper.RoomChangesV1Delete)
+	router.GET(options.BaseURL+"/v1/rooms", wrapper.RoomsV1List)
+	router.POST(options.BaseURL+"/v1/rooms", wrapper.RoomsV1Create)
+	router.DELETE(options.BaseURL+"/v1/rooms/:id", wrapper.RoomsV1Delete)
+	router.GET(options.BaseURL+"/v1/rooms/:id", wrapper.RoomsV1Detail)
+	router.PUT(options.BaseURL+"/v1/rooms/:id", wrapper.RoomsV1Update)
+	router.GET(options.BaseURL+"/v1/subjects", wrapper.SubjectsV1List)
+	router.DELETE(options.BaseURL+"/v1/subjects/:id", wrapper.SubjectsV1Delete)
+	router.GET(options.BaseURL+"/v1/subjects/:id", wrapper.SubjectsV1Detail)
+	router.GET(options.BaseURL+"/v1/subjects/:id/syllabus", wrapper.SyllabusV1Detail)
+	router.GET(options.BaseURL+"/v1/timetableItmes", wrapper.TimetableItemsV1List)
+	router.POST(options.BaseURL+"/v1/timetableItmes", wrapper.TimetableItemsV1Create)
+	router.DELETE(options.BaseURL+"/v1/timetableItmes/:id", wrapper.TimetableItemsV1Delete)
+	router.GET(options.BaseURL+"/v1/users", wrapper.UsersV1List)
+	router.GET(options.BaseURL+"/v1/users/:id", wrapper.UsersV1Detail)
+	router.POST(options.BaseURL+"/v1/users/:id", wrapper.UsersV1Upsert)
 }
 
-type FacultiesV1UpdateRequestObject struct {
-	Id   string `json:"id"`
-	Body *FacultiesV1UpdateJSONRequestBody
+type BadRequestApplicationProblemPlusJSONResponse Problem
+
+type ConflictApplicationProblemPlusJSONResponse Problem
+
+type ForbiddenApplicationProblemPlusJSONResponse Problem
+
+type NotFoundApplicationProblemPlusJSONResponse Problem
+
+type PreconditionFailedApplicationProblemPlusJSONResponse Problem
+
+type ProblemApplicationProblemPlusJSONResponse Problem
+
+type UnauthorizedApplicationProblemPlusJSONResponse Problem
+
+type UnprocessableEntityApplicationProblemPlusJSONResponse Problem
+
+type AnnouncementsV1ListRequestObject struct {
+	Params AnnouncementsV1ListParams
 }
 
-type FacultiesV1UpdateResponseObject interface {
-	VisitFacultiesV1UpdateResponse(w http.ResponseWriter) error
+type AnnouncementsV1ListResponseObject interface {
+	VisitAnnouncementsV1ListResponse(w http.ResponseWriter) error
 }
 
-type FacultiesV1Update200JSONResponse struct {
-	Faculty AcademicServiceFaculty `json:"faculty"`
+type AnnouncementsV1List200JSONResponse struct {
+	Announcements []AnnouncementServiceAnnouncement `json:"announcements"`
+
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
 }
 
-func (response FacultiesV1Update200JSONResponse) VisitFacultiesV1UpdateResponse(w http.ResponseWriter) error {
+func (response AnnouncementsV1List200JSONResponse) VisitAnnouncementsV1ListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type FacultiesV1Update401Response struct {
+type AnnouncementsV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
 }
 
-func (response FacultiesV1Update401Response) VisitFacultiesV1UpdateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+func (response AnnouncementsV1List400ApplicationProblemPlusJSONResponse) VisitAnnouncementsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
-type FacultiesV1Update404Response struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response FacultiesV1Update404Response) VisitFacultiesV1UpdateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+type AnnouncementsV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-type FacultyRoomsV1ListRequestObject struct {
-	Params FacultyRoomsV1ListParams
-}
+func (response AnnouncementsV1List401ApplicationProblemPlusJSONResponse) VisitAnnouncementsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
 
-type FacultyRoomsV1ListResponseObject interface {
-	VisitFacultyRoomsV1ListResponse(w http.ResponseWriter) error
+	return json.NewEncoder(w).Encode(response)
 }
 
-type FacultyRoomsV1List200JSONResponse struct {
-	FacultyRooms []AcademicServiceFacultyRoom `json:"facultyRooms"`
+type AnnouncementsV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (response FacultyRoomsV1List200JSONResponse) VisitFacultyRoomsV1ListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response AnnouncementsV1List403ApplicationProblemPlusJSONResponse) VisitAnnouncementsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type FacultyRoomsV1List401Response struct {
+type AnnouncementsV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-func (response FacultyRoomsV1List401Response) VisitFacultyRoomsV1ListResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+func (response AnnouncementsV1ListdefaultApplicationProblemPlusJSONResponse) VisitAnnouncementsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type FacultyRoomsV1CreateRequestObject struct {
-	Body *FacultyRoomsV1CreateJSONRequestBody
+type AnnouncementsV1CreateRequestObject struct {
+	Body *AnnouncementsV1CreateJSONRequestBody
 }
 
-type FacultyRoomsV1CreateResponseObject interface {
-	VisitFacultyRoomsV1CreateResponse(w http.ResponseWriter) error
+type AnnouncementsV1CreateResponseObject interface {
+	VisitAnnouncementsV1CreateResponse(w http.ResponseWriter) error
 }
 
-type FacultyRoomsV1Create201JSONResponse struct {
-	FacultyRoom AcademicServiceFacultyRoom `json:"facultyRoom"`
+type AnnouncementsV1Create201JSONResponse struct {
+	Announcement AnnouncementServiceAnnouncement `json:"announcement"`
 }
 
-func (response FacultyRoomsV1Create201JSONResponse) VisitFacultyRoomsV1CreateResponse(w http.ResponseWriter) error {
+func (response AnnouncementsV1Create201JSONResponse) VisitAnnouncementsV1CreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type FacultyRoomsV1Create401Response struct {
+type AnnouncementsV1Create401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response FacultyRoomsV1Create401Response) VisitFacultyRoomsV1CreateResponse(w http.ResponseWriter) error {
+func (response AnnouncementsV1Create401ApplicationProblemPlusJSONResponse) VisitAnnouncementsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type FacultyRoomsV1Create409Response struct {
+type AnnouncementsV1Create403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (response FacultyRoomsV1Create409Response) VisitFacultyRoomsV1CreateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(409)
-	return nil
+func (response AnnouncementsV1Create403ApplicationProblemPlusJSONResponse) VisitAnnouncementsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type FacultyRoomsV1DeleteRequestObject struct {
+type AnnouncementsV1CreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response AnnouncementsV1CreatedefaultApplicationProblemPlusJSONResponse) VisitAnnouncementsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AnnouncementsV1DeleteRequestObject struct {
 	Id string `json:"id"`
 }
 
-type FacultyRoomsV1DeleteResponseObject interface {
-	VisitFacultyRoomsV1DeleteResponse(w http.ResponseWriter) error
+type AnnouncementsV1DeleteResponseObject interface {
+	VisitAnnouncementsV1DeleteResponse(w http.ResponseWriter) error
 }
 
-type FacultyRoomsV1Delete204Response struct {
+type AnnouncementsV1Delete204Response struct {
 }
 
-func (response FacultyRoomsV1Delete204Response) VisitFacultyRoomsV1DeleteResponse(w http.ResponseWriter) error {
+func (response AnnouncementsV1Delete204Response) VisitAnnouncementsV1DeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type FacultyRoomsV1Delete401Response struct {
+type AnnouncementsV1Delete401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response FacultyRoomsV1Delete401Response) VisitFacultyRoomsV1DeleteResponse(w http.ResponseWriter) error {
+func (response AnnouncementsV1Delete401ApplicationProblemPlusJSONResponse) VisitAnnouncementsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
-	return nil
-}
 
-type FacultyRoomsV1Delete404Response struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response FacultyRoomsV1Delete404Response) VisitFacultyRoomsV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+type AnnouncementsV1Delete403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-type FCMTokenV1ListRequestObject struct {
-	Params FCMTokenV1ListParams
-}
+func (response AnnouncementsV1Delete403ApplicationProblemPlusJSONResponse) VisitAnnouncementsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
-type FCMTokenV1ListResponseObject interface {
-	VisitFCMTokenV1ListResponse(w http.ResponseWriter) error
+	return json.NewEncoder(w).Encode(response)
 }
 
-type FCMTokenV1List200JSONResponse struct {
-	FcmTokens []UserServiceFCMToken `json:"fcmTokens"`
+type AnnouncementsV1Delete404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response FCMTokenV1List200JSONResponse) VisitFCMTokenV1ListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response AnnouncementsV1Delete404ApplicationProblemPlusJSONResponse) VisitAnnouncementsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type FCMTokenV1List401Response struct {
+type AnnouncementsV1DeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-func (response FCMTokenV1List401Response) VisitFCMTokenV1ListResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+func (response AnnouncementsV1DeletedefaultApplicationProblemPlusJSONResponse) VisitAnnouncementsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type FCMTokenV1UpsertRequestObject struct {
-	Body *FCMTokenV1UpsertJSONRequestBody
+type AnnouncementsV1DetailRequestObject struct {
+	Id string `json:"id"`
 }
 
-type FCMTokenV1UpsertResponseObject interface {
-	VisitFCMTokenV1UpsertResponse(w http.ResponseWriter) error
+type AnnouncementsV1DetailResponseObject interface {
+	VisitAnnouncementsV1DetailResponse(w http.ResponseWriter) error
 }
 
-type FCMTokenV1Upsert200JSONResponse struct {
-	FcmToken UserServiceFCMToken `json:"fcmToken"`
+type AnnouncementsV1Detail200JSONResponse struct {
+	Announcement AnnouncementServiceAnnouncement `json:"announcement"`
 }
 
-func (response FCMTokenV1Upsert200JSONResponse) VisitFCMTokenV1UpsertResponse(w http.ResponseWriter) error {
+func (response AnnouncementsV1Detail200JSONResponse) VisitAnnouncementsV1DetailResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type FCMTokenV1Upsert401Response struct {
+type AnnouncementsV1Detail401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response FCMTokenV1Upsert401Response) VisitFCMTokenV1UpsertResponse(w http.ResponseWriter) error {
+func (response AnnouncementsV1Detail401ApplicationProblemPlusJSONResponse) VisitAnnouncementsV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type MakeupClassesV1ListRequestObject struct {
-	Params MakeupClassesV1ListParams
+type AnnouncementsV1Detail403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-type MakeupClassesV1ListResponseObject interface {
-	VisitMakeupClassesV1ListResponse(w http.ResponseWriter) error
+func (response AnnouncementsV1Detail403ApplicationProblemPlusJSONResponse) VisitAnnouncementsV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type MakeupClassesV1List200JSONResponse struct {
-	MakeupClasses []AcademicServiceMakeupClass `json:"makeupClasses"`
+type AnnouncementsV1Detail404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response MakeupClassesV1List200JSONResponse) VisitMakeupClassesV1ListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response AnnouncementsV1Detail404ApplicationProblemPlusJSONResponse) VisitAnnouncementsV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MakeupClassesV1List401Response struct {
+type AnnouncementsV1DetaildefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-func (response MakeupClassesV1List401Response) VisitMakeupClassesV1ListResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+func (response AnnouncementsV1DetaildefaultApplicationProblemPlusJSONResponse) VisitAnnouncementsV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type MakeupClassesV1CreateRequestObject struct {
-	Body *MakeupClassesV1CreateJSONRequestBody
+type AnnouncementsV1UpdateRequestObject struct {
+	Id     string `json:"id"`
+	Params AnnouncementsV1UpdateParams
+	Body   *AnnouncementsV1UpdateJSONRequestBody
 }
 
-type MakeupClassesV1CreateResponseObject interface {
-	VisitMakeupClassesV1CreateResponse(w http.ResponseWriter) error
+type AnnouncementsV1UpdateResponseObject interface {
+	VisitAnnouncementsV1UpdateResponse(w http.ResponseWriter) error
 }
 
-type MakeupClassesV1Create201JSONResponse struct {
-	// MakeupClass 補講
-	MakeupClass AcademicServiceMakeupClass `json:"makeupClass"`
+type AnnouncementsV1Update200JSONResponse struct {
+	Announcement AnnouncementServiceAnnouncement `json:"announcement"`
 }
 
-func (response MakeupClassesV1Create201JSONResponse) VisitMakeupClassesV1CreateResponse(w http.ResponseWriter) error {
+func (response AnnouncementsV1Update200JSONResponse) VisitAnnouncementsV1UpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MakeupClassesV1Create401Response struct {
+type AnnouncementsV1Update401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response MakeupClassesV1Create401Response) VisitMakeupClassesV1CreateResponse(w http.ResponseWriter) error {
+func (response AnnouncementsV1Update401ApplicationProblemPlusJSONResponse) VisitAnnouncementsV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
-	return nil
-}
-
-type MakeupClassesV1DeleteRequestObject struct {
-	Id string `json:"id"`
-}
-
-type MakeupClassesV1DeleteResponseObject interface {
-	VisitMakeupClassesV1DeleteResponse(w http.ResponseWriter) error
-}
 
-type MakeupClassesV1Delete204Response struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response MakeupClassesV1Delete204Response) VisitMakeupClassesV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type AnnouncementsV1Update403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-type MakeupClassesV1Delete401Response struct {
-}
+func (response AnnouncementsV1Update403ApplicationProblemPlusJSONResponse) VisitAnnouncementsV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
-func (response MakeupClassesV1Delete401Response) VisitMakeupClassesV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-type MakeupClassesV1Delete404Response struct {
+type AnnouncementsV1Update404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response MakeupClassesV1Delete404Response) VisitMakeupClassesV1DeleteResponse(w http.ResponseWriter) error {
+func (response AnnouncementsV1Update404ApplicationProblemPlusJSONResponse) VisitAnnouncementsV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
-	return nil
-}
-
-type MenuItemsV1ListRequestObject struct {
-	Params MenuItemsV1ListParams
-}
 
-type MenuItemsV1ListResponseObject interface {
-	VisitMenuItemsV1ListResponse(w http.ResponseWriter) error
+	return json.NewEncoder(w).Encode(response)
 }
 
-type MenuItemsV1List200JSONResponse struct {
-	MenuItems []FunchServiceMenuItem `json:"menuItems"`
+type AnnouncementsV1Update412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
 }
 
-func (response MenuItemsV1List200JSONResponse) VisitMenuItemsV1ListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response AnnouncementsV1Update412ApplicationProblemPlusJSONResponse) VisitAnnouncementsV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MenuItemsV1List401Response struct {
+type AnnouncementsV1UpdatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-func (response MenuItemsV1List401Response) VisitMenuItemsV1ListResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+func (response AnnouncementsV1UpdatedefaultApplicationProblemPlusJSONResponse) VisitAnnouncementsV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type NotificationV1ListRequestObject struct {
-	Params NotificationV1ListParams
+type AuditLogsV1ListRequestObject struct {
+	Params AuditLogsV1ListParams
 }
 
-type NotificationV1ListResponseObject interface {
-	VisitNotificationV1ListResponse(w http.ResponseWriter) error
+type AuditLogsV1ListResponseObject interface {
+	VisitAuditLogsV1ListResponse(w http.ResponseWriter) error
 }
 
-type NotificationV1List200JSONResponse struct {
-	Notifications []UserServiceNotification `json:"notifications"`
+type AuditLogsV1List200JSONResponse struct {
+	AuditLogs []AuditLog `json:"auditLogs"`
 }
 
-func (response NotificationV1List200JSONResponse) VisitNotificationV1ListResponse(w http.ResponseWriter) error {
+func (response AuditLogsV1List200JSONResponse) VisitAuditLogsV1ListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type NotificationV1List401Response struct {
+type AuditLogsV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response NotificationV1List401Response) VisitNotificationV1ListResponse(w http.ResponseWriter) error {
+func (response AuditLogsV1List401ApplicationProblemPlusJSONResponse) VisitAuditLogsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
-	return nil
-}
-
-type NotificationV1CreateRequestObject struct {
-	Body *NotificationV1CreateJSONRequestBody
-}
 
-type NotificationV1CreateResponseObject interface {
-	VisitNotificationV1CreateResponse(w http.ResponseWriter) error
+	return json.NewEncoder(w).Encode(response)
 }
 
-type NotificationV1Create201JSONResponse struct {
-	Notification UserServiceNotification `json:"notification"`
+type AuditLogsV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (response NotificationV1Create201JSONResponse) VisitNotificationV1CreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+func (response AuditLogsV1List403ApplicationProblemPlusJSONResponse) VisitAuditLogsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type NotificationV1Create401Response struct {
+type AuditLogsV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-func (response NotificationV1Create401Response) VisitNotificationV1CreateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
-
-type NotificationV1DispatchRequestObject struct {
-	Body *NotificationV1DispatchJSONRequestBody
-}
+func (response AuditLogsV1ListdefaultApplicationProblemPlusJSONResponse) VisitAuditLogsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
 
-type NotificationV1DispatchResponseObject interface {
-	VisitNotificationV1DispatchResponse(w http.ResponseWriter) error
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type NotificationV1Dispatch200JSONResponse struct {
-	Notifications []UserServiceNotification `json:"notifications"`
+type CacheV1FlushRequestObject struct {
 }
 
-func (response NotificationV1Dispatch200JSONResponse) VisitNotificationV1DispatchResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type CacheV1FlushResponseObject interface {
+	VisitCacheV1FlushResponse(w http.ResponseWriter) error
 }
 
-type NotificationV1Dispatch400Response struct {
+type CacheV1Flush204Response struct {
 }
 
-func (response NotificationV1Dispatch400Response) VisitNotificationV1DispatchResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
+func (response CacheV1Flush204Response) VisitCacheV1FlushResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
 	return nil
 }
 
-type NotificationV1Dispatch401Response struct {
+type CacheV1Flush401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response NotificationV1Dispatch401Response) VisitNotificationV1DispatchResponse(w http.ResponseWriter) error {
+func (response CacheV1Flush401ApplicationProblemPlusJSONResponse) VisitCacheV1FlushResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
-	return nil
-}
 
-type NotificationV1DeleteRequestObject struct {
-	Id string `json:"id"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type NotificationV1DeleteResponseObject interface {
-	VisitNotificationV1DeleteResponse(w http.ResponseWriter) error
+type CacheV1Flush403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-type NotificationV1Delete204Response struct {
-}
+func (response CacheV1Flush403ApplicationProblemPlusJSONResponse) VisitCacheV1FlushResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
-func (response NotificationV1Delete204Response) VisitNotificationV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-type NotificationV1Delete401Response struct {
+type CacheV1FlushdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-func (response NotificationV1Delete401Response) VisitNotificationV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+func (response CacheV1FlushdefaultApplicationProblemPlusJSONResponse) VisitCacheV1FlushResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
 
-type NotificationV1Delete404Response struct {
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-func (response NotificationV1Delete404Response) VisitNotificationV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+type CancelledClassesV1ListRequestObject struct {
+	Params CancelledClassesV1ListParams
 }
 
-type NotificationV1UpdateRequestObject struct {
-	Id   string `json:"id"`
-	Body *NotificationV1UpdateJSONRequestBody
+type CancelledClassesV1ListResponseObject interface {
+	VisitCancelledClassesV1ListResponse(w http.ResponseWriter) error
 }
 
-type NotificationV1UpdateResponseObject interface {
-	VisitNotificationV1UpdateResponse(w http.ResponseWriter) error
-}
+type CancelledClassesV1List200JSONResponse struct {
+	CancelledClasses []AcademicServiceCancelledClass `json:"cancelledClasses"`
 
-type NotificationV1Update200JSONResponse struct {
-	Notification UserServiceNotification `json:"notification"`
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
 }
 
-func (response NotificationV1Update200JSONResponse) VisitNotificationV1UpdateResponse(w http.ResponseWriter) error {
+func (response CancelledClassesV1List200JSONResponse) VisitCancelledClassesV1ListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type NotificationV1Update401Response struct {
+type CancelledClassesV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
 }
 
-func (response NotificationV1Update401Response) VisitNotificationV1UpdateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+func (response CancelledClassesV1List400ApplicationProblemPlusJSONResponse) VisitCancelledClassesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
-type NotificationV1Update404Response struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response NotificationV1Update404Response) VisitNotificationV1UpdateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+type CancelledClassesV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-type PersonalCalendarItemsV1ListRequestObject struct {
-	Params PersonalCalendarItemsV1ListParams
-}
+func (response CancelledClassesV1List401ApplicationProblemPlusJSONResponse) VisitCancelledClassesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
 
-type PersonalCalendarItemsV1ListResponseObject interface {
-	VisitPersonalCalendarItemsV1ListResponse(w http.ResponseWriter) error
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PersonalCalendarItemsV1List200JSONResponse struct {
-	PersonalCalendarItems []AcademicServicePersonalCalendarItem `json:"personalCalendarItems"`
+type CancelledClassesV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (response PersonalCalendarItemsV1List200JSONResponse) VisitPersonalCalendarItemsV1ListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response CancelledClassesV1List403ApplicationProblemPlusJSONResponse) VisitCancelledClassesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PersonalCalendarItemsV1List401Response struct {
+type CancelledClassesV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-func (response PersonalCalendarItemsV1List401Response) VisitPersonalCalendarItemsV1ListResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+func (response CancelledClassesV1ListdefaultApplicationProblemPlusJSONResponse) VisitCancelledClassesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type ReservationsV1ListRequestObject struct {
-	Params ReservationsV1ListParams
+type CancelledClassesV1CreateRequestObject struct {
+	Params CancelledClassesV1CreateParams
+	Body   *CancelledClassesV1CreateJSONRequestBody
 }
 
-type ReservationsV1ListResponseObject interface {
-	VisitReservationsV1ListResponse(w http.ResponseWriter) error
+type CancelledClassesV1CreateResponseObject interface {
+	VisitCancelledClassesV1CreateResponse(w http.ResponseWriter) error
 }
 
-type ReservationsV1List200JSONResponse struct {
-	Reservations []AcademicServiceReservation `json:"reservations"`
+type CancelledClassesV1Create201JSONResponse struct {
+	// CancelledClass 休講
+	CancelledClass AcademicServiceCancelledClass `json:"cancelledClass"`
+
+	// NotificationError RFC 7807 Problem Details
+	NotificationError *Problem `json:"notificationError,omitempty"`
+
+	// NotificationId notify を指定した場合に作成した通知のID; 履修者がいない場合は省略する
+	NotificationId *string `json:"notificationId,omitempty"`
 }
 
-func (response ReservationsV1List200JSONResponse) VisitReservationsV1ListResponse(w http.ResponseWriter) error {
+func (response CancelledClassesV1Create201JSONResponse) VisitCancelledClassesV1CreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReservationsV1List401Response struct {
+type CancelledClassesV1Create401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response ReservationsV1List401Response) VisitReservationsV1ListResponse(w http.ResponseWriter) error {
+func (response CancelledClassesV1Create401ApplicationProblemPlusJSONResponse) VisitCancelledClassesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
-	return nil
-}
-
-type ReservationsV1CreateRequestObject struct {
-	Body *ReservationsV1CreateJSONRequestBody
-}
 
-type ReservationsV1CreateResponseObject interface {
-	VisitReservationsV1CreateResponse(w http.ResponseWriter) error
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ReservationsV1Create201JSONResponse struct {
-	Reservation AcademicServiceReservation `json:"reservation"`
+type CancelledClassesV1Create403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (response ReservationsV1Create201JSONResponse) VisitReservationsV1CreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+func (response CancelledClassesV1Create403ApplicationProblemPlusJSONResponse) VisitCancelledClassesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReservationsV1Create401Response struct {
+type CancelledClassesV1CreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-func (response ReservationsV1Create401Response) VisitReservationsV1CreateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+func (response CancelledClassesV1CreatedefaultApplicationProblemPlusJSONResponse) VisitCancelledClassesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type ReservationsV1DeleteRequestObject struct {
+type CancelledClassesV1DeleteRequestObject struct {
 	Id string `json:"id"`
 }
 
-type ReservationsV1DeleteResponseObject interface {
-	VisitReservationsV1DeleteResponse(w http.ResponseWriter) error
+type CancelledClassesV1DeleteResponseObject interface {
+	VisitCancelledClassesV1DeleteResponse(w http.ResponseWriter) error
 }
 
-type ReservationsV1Delete204Response struct {
+type CancelledClassesV1Delete204Response struct {
 }
 
-func (response ReservationsV1Delete204Response) VisitReservationsV1DeleteResponse(w http.ResponseWriter) error {
+func (response CancelledClassesV1Delete204Response) VisitCancelledClassesV1DeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type ReservationsV1Delete401Response struct {
+type CancelledClassesV1Delete401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response ReservationsV1Delete401Response) VisitReservationsV1DeleteResponse(w http.ResponseWriter) error {
+func (response CancelledClassesV1Delete401ApplicationProblemPlusJSONResponse) VisitCancelledClassesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
-	return nil
-}
 
-type ReservationsV1Delete404Response struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response ReservationsV1Delete404Response) VisitReservationsV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+type CancelledClassesV1Delete403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-type RoomChangesV1ListRequestObject struct {
-	Params RoomChangesV1ListParams
-}
+func (response CancelledClassesV1Delete403ApplicationProblemPlusJSONResponse) VisitCancelledClassesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
-type RoomChangesV1ListResponseObject interface {
-	VisitRoomChangesV1ListResponse(w http.ResponseWriter) error
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoomChangesV1List200JSONResponse struct {
-	RoomChanges []AcademicServiceRoomChange `json:"roomChanges"`
+type CancelledClassesV1Delete404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response RoomChangesV1List200JSONResponse) VisitRoomChangesV1ListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response CancelledClassesV1Delete404ApplicationProblemPlusJSONResponse) VisitCancelledClassesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoomChangesV1List401Response struct {
+type CancelledClassesV1DeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-func (response RoomChangesV1List401Response) VisitRoomChangesV1ListResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+func (response CancelledClassesV1DeletedefaultApplicationProblemPlusJSONResponse) VisitCancelledClassesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type RoomChangesV1CreateRequestObject struct {
-	Body *RoomChangesV1CreateJSONRequestBody
+type CourseRegistrationsV1ListRequestObject struct {
+	Params CourseRegistrationsV1ListParams
 }
 
-type RoomChangesV1CreateResponseObject interface {
-	VisitRoomChangesV1CreateResponse(w http.ResponseWriter) error
+type CourseRegistrationsV1ListResponseObject interface {
+	VisitCourseRegistrationsV1ListResponse(w http.ResponseWriter) error
 }
 
-type RoomChangesV1Create201JSONResponse struct {
-	// RoomChange 教室変更
-	RoomChange AcademicServiceRoomChange `json:"roomChange"`
+type CourseRegistrationsV1List200JSONResponse struct {
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor    *string                             `json:"nextCursor,omitempty"`
+	Registrations []AcademicServiceCourseRegistration `json:"registrations"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
 }
 
-func (response RoomChangesV1Create201JSONResponse) VisitRoomChangesV1CreateResponse(w http.ResponseWriter) error {
+func (response CourseRegistrationsV1List200JSONResponse) VisitCourseRegistrationsV1ListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoomChangesV1Create401Response struct {
+type CourseRegistrationsV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
 }
 
-func (response RoomChangesV1Create401Response) VisitRoomChangesV1CreateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+func (response CourseRegistrationsV1List400ApplicationProblemPlusJSONResponse) VisitCourseRegistrationsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
-type RoomChangesV1DeleteRequestObject struct {
-	Id string `json:"id"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoomChangesV1DeleteResponseObject interface {
-	VisitRoomChangesV1DeleteResponse(w http.ResponseWriter) error
+type CourseRegistrationsV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-type RoomChangesV1Delete204Response struct {
-}
+func (response CourseRegistrationsV1List401ApplicationProblemPlusJSONResponse) VisitCourseRegistrationsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
 
-func (response RoomChangesV1Delete204Response) VisitRoomChangesV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoomChangesV1Delete401Response struct {
+type CourseRegistrationsV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (response RoomChangesV1Delete401Response) VisitRoomChangesV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+func (response CourseRegistrationsV1List403ApplicationProblemPlusJSONResponse) VisitCourseRegistrationsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoomChangesV1Delete404Response struct {
+type CourseRegistrationsV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-func (response RoomChangesV1Delete404Response) VisitRoomChangesV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+func (response CourseRegistrationsV1ListdefaultApplicationProblemPlusJSONResponse) VisitCourseRegistrationsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type RoomsV1ListRequestObject struct {
-	Params RoomsV1ListParams
+type CourseRegistrationsV1CreateRequestObject struct {
+	Body *CourseRegistrationsV1CreateJSONRequestBody
 }
 
-type RoomsV1ListResponseObject interface {
-	VisitRoomsV1ListResponse(w http.ResponseWriter) error
+type CourseRegistrationsV1CreateResponseObject interface {
+	VisitCourseRegistrationsV1CreateResponse(w http.ResponseWriter) error
 }
 
-type RoomsV1List200JSONResponse struct {
-	Rooms []AcademicServiceRoom `json:"rooms"`
+type CourseRegistrationsV1Create201JSONResponse struct {
+	Registration AcademicServiceCourseRegistration `json:"registration"`
 }
 
-func (response RoomsV1List200JSONResponse) VisitRoomsV1ListResponse(w http.ResponseWriter) error {
+func (response CourseRegistrationsV1Create201JSONResponse) VisitCourseRegistrationsV1CreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoomsV1List401Response struct {
+type CourseRegistrationsV1Create401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response RoomsV1List401Response) VisitRoomsV1ListResponse(w http.ResponseWriter) error {
+func (response CourseRegistrationsV1Create401ApplicationProblemPlusJSONResponse) VisitCourseRegistrationsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
-	return nil
-}
-
-type RoomsV1CreateRequestObject struct {
-	Body *RoomsV1CreateJSONRequestBody
-}
 
-type RoomsV1CreateResponseObject interface {
-	VisitRoomsV1CreateResponse(w http.ResponseWriter) error
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoomsV1Create201JSONResponse struct {
-	Room AcademicServiceRoom `json:"room"`
+type CourseRegistrationsV1Create403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (response RoomsV1Create201JSONResponse) VisitRoomsV1CreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+func (response CourseRegistrationsV1Create403ApplicationProblemPlusJSONResponse) VisitCourseRegistrationsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoomsV1Create401Response struct {
+type CourseRegistrationsV1CreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-func (response RoomsV1Create401Response) VisitRoomsV1CreateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+func (response CourseRegistrationsV1CreatedefaultApplicationProblemPlusJSONResponse) VisitCourseRegistrationsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type RoomsV1DeleteRequestObject struct {
+type CourseRegistrationsV1DeleteRequestObject struct {
 	Id string `json:"id"`
 }
 
-type RoomsV1DeleteResponseObject interface {
-	VisitRoomsV1DeleteResponse(w http.ResponseWriter) error
+type CourseRegistrationsV1DeleteResponseObject interface {
+	VisitCourseRegistrationsV1DeleteResponse(w http.ResponseWriter) error
 }
 
-type RoomsV1Delete204Response struct {
+type CourseRegistrationsV1Delete204Response struct {
 }
 
-func (response RoomsV1Delete204Response) VisitRoomsV1DeleteResponse(w http.ResponseWriter) error {
+func (response CourseRegistrationsV1Delete204Response) VisitCourseRegistrationsV1DeleteResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type RoomsV1Delete401Response struct {
+type CourseRegistrationsV1Delete401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response RoomsV1Delete401Response) VisitRoomsV1DeleteResponse(w http.ResponseWriter) error {
+func (response CourseRegistrationsV1Delete401ApplicationProblemPlusJSONResponse) VisitCourseRegistrationsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoomsV1Delete404Response struct {
+type CourseRegistrationsV1Delete403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (response RoomsV1Delete404Response) VisitRoomsV1DeleteResponse(w http.ResponseWriter) error {
+func (response CourseRegistrationsV1Delete403ApplicationProblemPlusJSONResponse) VisitCourseRegistrationsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CourseRegistrationsV1Delete404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response CourseRegistrationsV1Delete404ApplicationProblemPlusJSONResponse) VisitCourseRegistrationsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoomsV1Delete409Response struct {
+type CourseRegistrationsV1DeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-func (response RoomsV1Delete409Response) VisitRoomsV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(409)
-	return nil
+func (response CourseRegistrationsV1DeletedefaultApplicationProblemPlusJSONResponse) VisitCourseRegistrationsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type RoomsV1DetailRequestObject struct {
-	Id string `json:"id"`
+type FacultiesV1ListRequestObject struct {
+	Params FacultiesV1ListParams
 }
 
-type RoomsV1DetailResponseObject interface {
-	VisitRoomsV1DetailResponse(w http.ResponseWriter) error
+type FacultiesV1ListResponseObject interface {
+	VisitFacultiesV1ListResponse(w http.ResponseWriter) error
 }
 
-type RoomsV1Detail200JSONResponse struct {
-	Room AcademicServiceRoom `json:"room"`
+type FacultiesV1List200JSONResponse struct {
+	Faculties []AcademicServiceFaculty `json:"faculties"`
+
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
 }
 
-func (response RoomsV1Detail200JSONResponse) VisitRoomsV1DetailResponse(w http.ResponseWriter) error {
+func (response FacultiesV1List200JSONResponse) VisitFacultiesV1ListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoomsV1Detail401Response struct {
+type FacultiesV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
 }
 
-func (response RoomsV1Detail401Response) VisitRoomsV1DetailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+func (response FacultiesV1List400ApplicationProblemPlusJSONResponse) VisitFacultiesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
-type RoomsV1Detail404Response struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response RoomsV1Detail404Response) VisitRoomsV1DetailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+type FacultiesV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-type RoomsV1UpdateRequestObject struct {
-	Id   string `json:"id"`
-	Body *RoomsV1UpdateJSONRequestBody
-}
+func (response FacultiesV1List401ApplicationProblemPlusJSONResponse) VisitFacultiesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
 
-type RoomsV1UpdateResponseObject interface {
-	VisitRoomsV1UpdateResponse(w http.ResponseWriter) error
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RoomsV1Update200JSONResponse struct {
-	Room AcademicServiceRoom `json:"room"`
+type FacultiesV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (response RoomsV1Update200JSONResponse) VisitRoomsV1UpdateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response FacultiesV1List403ApplicationProblemPlusJSONResponse) VisitFacultiesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RoomsV1Update401Response struct {
-}
-
-func (response RoomsV1Update401Response) VisitRoomsV1UpdateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+type FacultiesV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-type RoomsV1Update404Response struct {
-}
+func (response FacultiesV1ListdefaultApplicationProblemPlusJSONResponse) VisitFacultiesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
 
-func (response RoomsV1Update404Response) VisitRoomsV1UpdateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type SubjectsV1ListRequestObject struct {
-	Params SubjectsV1ListParams
+type FacultiesV1CreateRequestObject struct {
+	Body *FacultiesV1CreateJSONRequestBody
 }
 
-type SubjectsV1ListResponseObject interface {
-	VisitSubjectsV1ListResponse(w http.ResponseWriter) error
+type FacultiesV1CreateResponseObject interface {
+	VisitFacultiesV1CreateResponse(w http.ResponseWriter) error
 }
 
-type SubjectsV1List200JSONResponse struct {
-	Subjects []AcademicServiceSubject `json:"subjects"`
+type FacultiesV1Create201JSONResponse struct {
+	Faculty AcademicServiceFaculty `json:"faculty"`
 }
 
-func (response SubjectsV1List200JSONResponse) VisitSubjectsV1ListResponse(w http.ResponseWriter) error {
+func (response FacultiesV1Create201JSONResponse) VisitFacultiesV1CreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SubjectsV1List401Response struct {
+type FacultiesV1Create401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response SubjectsV1List401Response) VisitSubjectsV1ListResponse(w http.ResponseWriter) error {
+func (response FacultiesV1Create401ApplicationProblemPlusJSONResponse) VisitFacultiesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SubjectsV1DeleteRequestObject struct {
-	Id string `json:"id"`
+type FacultiesV1Create403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-type SubjectsV1DeleteResponseObject interface {
-	VisitSubjectsV1DeleteResponse(w http.ResponseWriter) error
+func (response FacultiesV1Create403ApplicationProblemPlusJSONResponse) VisitFacultiesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SubjectsV1Delete204Response struct {
+type FacultiesV1CreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-func (response SubjectsV1Delete204Response) VisitSubjectsV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response FacultiesV1CreatedefaultApplicationProblemPlusJSONResponse) VisitFacultiesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type SubjectsV1Delete401Response struct {
+type FacultiesV1DeleteRequestObject struct {
+	Id string `json:"id"`
 }
 
-func (response SubjectsV1Delete401Response) VisitSubjectsV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+type FacultiesV1DeleteResponseObject interface {
+	VisitFacultiesV1DeleteResponse(w http.ResponseWriter) error
 }
 
-type SubjectsV1Delete404Response struct {
+type FacultiesV1Delete204Response struct {
 }
 
-func (response SubjectsV1Delete404Response) VisitSubjectsV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
+func (response FacultiesV1Delete204Response) VisitFacultiesV1DeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
 	return nil
 }
 
-type SubjectsV1Delete409Response struct {
+type FacultiesV1Delete401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response SubjectsV1Delete409Response) VisitSubjectsV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(409)
-	return nil
+func (response FacultiesV1Delete401ApplicationProblemPlusJSONResponse) VisitFacultiesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SubjectsV1DetailRequestObject struct {
-	Id string `json:"id"`
+type FacultiesV1Delete403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-type SubjectsV1DetailResponseObject interface {
-	VisitSubjectsV1DetailResponse(w http.ResponseWriter) error
+func (response FacultiesV1Delete403ApplicationProblemPlusJSONResponse) VisitFacultiesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SubjectsV1Detail200JSONResponse struct {
-	Subject AcademicServiceSubject `json:"subject"`
+type FacultiesV1Delete404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response SubjectsV1Detail200JSONResponse) VisitSubjectsV1DetailResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response FacultiesV1Delete404ApplicationProblemPlusJSONResponse) VisitFacultiesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SubjectsV1Detail401Response struct {
+type FacultiesV1Delete409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
 }
 
-func (response SubjectsV1Detail401Response) VisitSubjectsV1DetailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+func (response FacultiesV1Delete409ApplicationProblemPlusJSONResponse) VisitFacultiesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SubjectsV1Detail404Response struct {
+type FacultiesV1DeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-func (response SubjectsV1Detail404Response) VisitSubjectsV1DetailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+func (response FacultiesV1DeletedefaultApplicationProblemPlusJSONResponse) VisitFacultiesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type TimetableItemsV1ListRequestObject struct {
-	Params TimetableItemsV1ListParams
+type FacultiesV1DetailRequestObject struct {
+	Id string `json:"id"`
 }
 
-type TimetableItemsV1ListResponseObject interface {
-	VisitTimetableItemsV1ListResponse(w http.ResponseWriter) error
+type FacultiesV1DetailResponseObject interface {
+	VisitFacultiesV1DetailResponse(w http.ResponseWriter) error
 }
 
-type TimetableItemsV1List200JSONResponse struct {
-	Items []AcademicServiceTimetableItem `json:"items"`
+type FacultiesV1Detail200JSONResponse struct {
+	Faculty AcademicServiceFaculty `json:"faculty"`
 }
 
-func (response TimetableItemsV1List200JSONResponse) VisitTimetableItemsV1ListResponse(w http.ResponseWriter) error {
+func (response FacultiesV1Detail200JSONResponse) VisitFacultiesV1DetailResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TimetableItemsV1List401Response struct {
+type FacultiesV1Detail401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response TimetableItemsV1List401Response) VisitTimetableItemsV1ListResponse(w http.ResponseWriter) error {
+func (response FacultiesV1Detail401ApplicationProblemPlusJSONResponse) VisitFacultiesV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TimetableItemsV1CreateRequestObject struct {
-	Body *TimetableItemsV1CreateJSONRequestBody
+type FacultiesV1Detail403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-type TimetableItemsV1CreateResponseObject interface {
-	VisitTimetableItemsV1CreateResponse(w http.ResponseWriter) error
+func (response FacultiesV1Detail403ApplicationProblemPlusJSONResponse) VisitFacultiesV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TimetableItemsV1Create201JSONResponse struct {
-	Item AcademicServiceTimetableItem `json:"item"`
+type FacultiesV1Detail404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response TimetableItemsV1Create201JSONResponse) VisitTimetableItemsV1CreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+func (response FacultiesV1Detail404ApplicationProblemPlusJSONResponse) VisitFacultiesV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type TimetableItemsV1Create401Response struct {
+type FacultiesV1DetaildefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-func (response TimetableItemsV1Create401Response) VisitTimetableItemsV1CreateResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+func (response FacultiesV1DetaildefaultApplicationProblemPlusJSONResponse) VisitFacultiesV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type TimetableItemsV1DeleteRequestObject struct {
-	Id string `json:"id"`
+type FacultiesV1UpdateRequestObject struct {
+	Id     string `json:"id"`
+	Params FacultiesV1UpdateParams
+	Body   *FacultiesV1UpdateJSONRequestBody
 }
 
-type TimetableItemsV1DeleteResponseObject interface {
-	VisitTimetableItemsV1DeleteResponse(w http.ResponseWriter) error
+type FacultiesV1UpdateResponseObject interface {
+	VisitFacultiesV1UpdateResponse(w http.ResponseWriter) error
 }
 
-type TimetableItemsV1Delete204Response struct {
+type FacultiesV1Update200JSONResponse struct {
+	Faculty AcademicServiceFaculty `json:"faculty"`
 }
 
-func (response TimetableItemsV1Delete204Response) VisitTimetableItemsV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response FacultiesV1Update200JSONResponse) VisitFacultiesV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type TimetableItemsV1Delete401Response struct {
+type FacultiesV1Update401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response TimetableItemsV1Delete401Response) VisitTimetableItemsV1DeleteResponse(w http.ResponseWriter) error {
+func (response FacultiesV1Update401ApplicationProblemPlusJSONResponse) VisitFacultiesV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
-	return nil
-}
 
-type TimetableItemsV1Delete404Response struct {
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response TimetableItemsV1Delete404Response) VisitTimetableItemsV1DeleteResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+type FacultiesV1Update403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-type UsersV1ListRequestObject struct {
-}
+func (response FacultiesV1Update403ApplicationProblemPlusJSONResponse) VisitFacultiesV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
-type UsersV1ListResponseObject interface {
-	VisitUsersV1ListResponse(w http.ResponseWriter) error
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UsersV1List200JSONResponse struct {
-	Users []UserServiceUser `json:"users"`
+type FacultiesV1Update404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response UsersV1List200JSONResponse) VisitUsersV1ListResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response FacultiesV1Update404ApplicationProblemPlusJSONResponse) VisitFacultiesV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UsersV1List401Response struct {
+type FacultiesV1Update412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
 }
 
-func (response UsersV1List401Response) VisitUsersV1ListResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+func (response FacultiesV1Update412ApplicationProblemPlusJSONResponse) VisitFacultiesV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UsersV1DetailRequestObject struct {
-	Id string `json:"id"`
+type FacultiesV1UpdatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-type UsersV1DetailResponseObject interface {
-	VisitUsersV1DetailResponse(w http.ResponseWriter) error
+func (response FacultiesV1UpdatedefaultApplicationProblemPlusJSONResponse) VisitFacultiesV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type UsersV1Detail200JSONResponse struct {
-	User UserServiceUser `json:"user"`
+type FacultyRoomsV1ListRequestObject struct {
+	Params FacultyRoomsV1ListParams
 }
 
-func (response UsersV1Detail200JSONResponse) VisitUsersV1DetailResponse(w http.ResponseWriter) error {
+type FacultyRoomsV1ListResponseObject interface {
+	VisitFacultyRoomsV1ListResponse(w http.ResponseWriter) error
+}
+
+type FacultyRoomsV1List200JSONResponse struct {
+	FacultyRooms []AcademicServiceFacultyRoom `json:"facultyRooms"`
+
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
+}
+
+func (response FacultyRoomsV1List200JSONResponse) VisitFacultyRoomsV1ListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UsersV1Detail401Response struct {
+type FacultyRoomsV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
 }
 
-func (response UsersV1Detail401Response) VisitUsersV1DetailResponse(w http.ResponseWriter) error {
+func (response FacultyRoomsV1List400ApplicationProblemPlusJSONResponse) VisitFacultyRoomsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FacultyRoomsV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response FacultyRoomsV1List401ApplicationProblemPlusJSONResponse) VisitFacultyRoomsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UsersV1Detail404Response struct {
+type FacultyRoomsV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (response UsersV1Detail404Response) VisitUsersV1DetailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+func (response FacultyRoomsV1List403ApplicationProblemPlusJSONResponse) VisitFacultyRoomsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UsersV1UpsertRequestObject struct {
-	Id   string `json:"id"`
-	Body *UsersV1UpsertJSONRequestBody
+type FacultyRoomsV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
 }
 
-type UsersV1UpsertResponseObject interface {
-	VisitUsersV1UpsertResponse(w http.ResponseWriter) error
+func (response FacultyRoomsV1ListdefaultApplicationProblemPlusJSONResponse) VisitFacultyRoomsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type UsersV1Upsert200JSONResponse struct {
-	User UserServiceUser `json:"user"`
+type FacultyRoomsV1CreateRequestObject struct {
+	Body *FacultyRoomsV1CreateJSONRequestBody
 }
 
-func (response UsersV1Upsert200JSONResponse) VisitUsersV1UpsertResponse(w http.ResponseWriter) error {
+type FacultyRoomsV1CreateResponseObject interface {
+	VisitFacultyRoomsV1CreateResponse(w http.ResponseWriter) error
+}
+
+type FacultyRoomsV1Create201JSONResponse struct {
+	FacultyRoom AcademicServiceFacultyRoom `json:"facultyRoom"`
+}
+
+func (response FacultyRoomsV1Create201JSONResponse) VisitFacultyRoomsV1CreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UsersV1Upsert401Response struct {
+type FacultyRoomsV1Create401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response UsersV1Upsert401Response) VisitUsersV1UpsertResponse(w http.ResponseWriter) error {
+func (response FacultyRoomsV1Create401ApplicationProblemPlusJSONResponse) VisitFacultyRoomsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+type FacultyRoomsV1Create403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
 
-	// (GET /v1/announcements)
-	AnnouncementsV1List(ctx context.Context, request AnnouncementsV1ListRequestObject) (AnnouncementsV1ListResponseObject, error)
+func (response FacultyRoomsV1Create403ApplicationProblemPlusJSONResponse) VisitFacultyRoomsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
-	// (POST /v1/announcements)
-	AnnouncementsV1Create(ctx context.Context, request AnnouncementsV1CreateRequestObject) (AnnouncementsV1CreateResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (DELETE /v1/announcements/{id})
-	AnnouncementsV1Delete(ctx context.Context, request AnnouncementsV1DeleteRequestObject) (AnnouncementsV1DeleteResponseObject, error)
+type FacultyRoomsV1Create409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
 
-	// (GET /v1/announcements/{id})
-	AnnouncementsV1Detail(ctx context.Context, request AnnouncementsV1DetailRequestObject) (AnnouncementsV1DetailResponseObject, error)
+func (response FacultyRoomsV1Create409ApplicationProblemPlusJSONResponse) VisitFacultyRoomsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
 
-	// (PUT /v1/announcements/{id})
-	AnnouncementsV1Update(ctx context.Context, request AnnouncementsV1UpdateRequestObject) (AnnouncementsV1UpdateResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (GET /v1/cancelledClasses)
-	CancelledClassesV1List(ctx context.Context, request CancelledClassesV1ListRequestObject) (CancelledClassesV1ListResponseObject, error)
+type FacultyRoomsV1CreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
 
-	// (POST /v1/cancelledClasses)
-	CancelledClassesV1Create(ctx context.Context, request CancelledClassesV1CreateRequestObject) (CancelledClassesV1CreateResponseObject, error)
+func (response FacultyRoomsV1CreatedefaultApplicationProblemPlusJSONResponse) VisitFacultyRoomsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
 
-	// (DELETE /v1/cancelledClasses/{id})
-	CancelledClassesV1Delete(ctx context.Context, request CancelledClassesV1DeleteRequestObject) (CancelledClassesV1DeleteResponseObject, error)
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	// (GET /v1/courseRegistrations)
-	CourseRegistrationsV1List(ctx context.Context, request CourseRegistrationsV1ListRequestObject) (CourseRegistrationsV1ListResponseObject, error)
+type FacultyRoomsV1DeleteRequestObject struct {
+	Id string `json:"id"`
+}
 
-	// (POST /v1/courseRegistrations)
-	CourseRegistrationsV1Create(ctx context.Context, request CourseRegistrationsV1CreateRequestObject) (CourseRegistrationsV1CreateResponseObject, error)
+type FacultyRoomsV1DeleteResponseObject interface {
+	VisitFacultyRoomsV1DeleteResponse(w http.ResponseWriter) error
+}
 
-	// (DELETE /v1/courseRegistrations/{id})
-	CourseRegistrationsV1Delete(ctx context.Context, request CourseRegistrationsV1DeleteRequestObject) (CourseRegistrationsV1DeleteResponseObject, error)
+type FacultyRoomsV1Delete204Response struct {
+}
 
-	// (GET /v1/faculties)
-	FacultiesV1List(ctx context.Context, request FacultiesV1ListRequestObject) (FacultiesV1ListResponseObject, error)
+func (response FacultyRoomsV1Delete204Response) VisitFacultyRoomsV1DeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
 
-	// (POST /v1/faculties)
-	FacultiesV1Create(ctx context.Context, request FacultiesV1CreateRequestObject) (FacultiesV1CreateResponseObject, error)
+type FacultyRoomsV1Delete401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
 
-	// (DELETE /v1/faculties/{id})
-	FacultiesV1Delete(ctx context.Context, request FacultiesV1DeleteRequestObject) (FacultiesV1DeleteResponseObject, error)
+func (response FacultyRoomsV1Delete401ApplicationProblemPlusJSONResponse) VisitFacultyRoomsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
 
-	// (GET /v1/faculties/{id})
-	FacultiesV1Detail(ctx context.Context, request FacultiesV1DetailRequestObject) (FacultiesV1DetailResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (PUT /v1/faculties/{id})
-	FacultiesV1Update(ctx context.Context, request FacultiesV1UpdateRequestObject) (FacultiesV1UpdateResponseObject, error)
+type FacultyRoomsV1Delete403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
 
-	// (GET /v1/facultyRooms)
-	FacultyRoomsV1List(ctx context.Context, request FacultyRoomsV1ListRequestObject) (FacultyRoomsV1ListResponseObject, error)
+func (response FacultyRoomsV1Delete403ApplicationProblemPlusJSONResponse) VisitFacultyRoomsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
-	// (POST /v1/facultyRooms)
-	FacultyRoomsV1Create(ctx context.Context, request FacultyRoomsV1CreateRequestObject) (FacultyRoomsV1CreateResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (DELETE /v1/facultyRooms/{id})
-	FacultyRoomsV1Delete(ctx context.Context, request FacultyRoomsV1DeleteRequestObject) (FacultyRoomsV1DeleteResponseObject, error)
+type FacultyRoomsV1Delete404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
 
-	// (GET /v1/fcmTokens)
-	FCMTokenV1List(ctx context.Context, request FCMTokenV1ListRequestObject) (FCMTokenV1ListResponseObject, error)
+func (response FacultyRoomsV1Delete404ApplicationProblemPlusJSONResponse) VisitFacultyRoomsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
-	// (POST /v1/fcmTokens)
-	FCMTokenV1Upsert(ctx context.Context, request FCMTokenV1UpsertRequestObject) (FCMTokenV1UpsertResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (GET /v1/makeupClasses)
-	MakeupClassesV1List(ctx context.Context, request MakeupClassesV1ListRequestObject) (MakeupClassesV1ListResponseObject, error)
+type FacultyRoomsV1DeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
 
-	// (POST /v1/makeupClasses)
-	MakeupClassesV1Create(ctx context.Context, request MakeupClassesV1CreateRequestObject) (MakeupClassesV1CreateResponseObject, error)
+func (response FacultyRoomsV1DeletedefaultApplicationProblemPlusJSONResponse) VisitFacultyRoomsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
 
-	// (DELETE /v1/makeupClasses/{id})
-	MakeupClassesV1Delete(ctx context.Context, request MakeupClassesV1DeleteRequestObject) (MakeupClassesV1DeleteResponseObject, error)
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	// (GET /v1/menuItems)
-	MenuItemsV1List(ctx context.Context, request MenuItemsV1ListRequestObject) (MenuItemsV1ListResponseObject, error)
+type FCMTokenV1ListRequestObject struct {
+	Params FCMTokenV1ListParams
+}
 
-	// (GET /v1/notifications)
-	NotificationV1List(ctx context.Context, request NotificationV1ListRequestObject) (NotificationV1ListResponseObject, error)
+type FCMTokenV1ListResponseObject interface {
+	VisitFCMTokenV1ListResponse(w http.ResponseWriter) error
+}
 
-	// (POST /v1/notifications)
-	NotificationV1Create(ctx context.Context, request NotificationV1CreateRequestObject) (NotificationV1CreateResponseObject, error)
+type FCMTokenV1List200JSONResponse struct {
+	FcmTokens []UserServiceFCMToken `json:"fcmTokens"`
 
-	// (POST /v1/notifications/dispatch)
-	NotificationV1Dispatch(ctx context.Context, request NotificationV1DispatchRequestObject) (NotificationV1DispatchResponseObject, error)
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor *string `json:"nextCursor,omitempty"`
 
-	// (DELETE /v1/notifications/{id})
-	NotificationV1Delete(ctx context.Context, request NotificationV1DeleteRequestObject) (NotificationV1DeleteResponseObject, error)
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
+}
 
-	// (PUT /v1/notifications/{id})
-	NotificationV1Update(ctx context.Context, request NotificationV1UpdateRequestObject) (NotificationV1UpdateResponseObject, error)
+func (response FCMTokenV1List200JSONResponse) VisitFCMTokenV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// (GET /v1/personalCalendarItems)
-	PersonalCalendarItemsV1List(ctx context.Context, request PersonalCalendarItemsV1ListRequestObject) (PersonalCalendarItemsV1ListResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (GET /v1/reservations)
-	ReservationsV1List(ctx context.Context, request ReservationsV1ListRequestObject) (ReservationsV1ListResponseObject, error)
+type FCMTokenV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
 
-	// (POST /v1/reservations)
-	ReservationsV1Create(ctx context.Context, request ReservationsV1CreateRequestObject) (ReservationsV1CreateResponseObject, error)
+func (response FCMTokenV1List400ApplicationProblemPlusJSONResponse) VisitFCMTokenV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
-	// (DELETE /v1/reservations/{id})
-	ReservationsV1Delete(ctx context.Context, request ReservationsV1DeleteRequestObject) (ReservationsV1DeleteResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (GET /v1/roomChanges)
-	RoomChangesV1List(ctx context.Context, request RoomChangesV1ListRequestObject) (RoomChangesV1ListResponseObject, error)
+type FCMTokenV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
 
-	// (POST /v1/roomChanges)
-	RoomChangesV1Create(ctx context.Context, request RoomChangesV1CreateRequestObject) (RoomChangesV1CreateResponseObject, error)
+func (response FCMTokenV1List401ApplicationProblemPlusJSONResponse) VisitFCMTokenV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
 
-	// (DELETE /v1/roomChanges/{id})
-	RoomChangesV1Delete(ctx context.Context, request RoomChangesV1DeleteRequestObject) (RoomChangesV1DeleteResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (GET /v1/rooms)
-	RoomsV1List(ctx context.Context, request RoomsV1ListRequestObject) (RoomsV1ListResponseObject, error)
+type FCMTokenV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
 
-	// (POST /v1/rooms)
-	RoomsV1Create(ctx context.Context, request RoomsV1CreateRequestObject) (RoomsV1CreateResponseObject, error)
+func (response FCMTokenV1List403ApplicationProblemPlusJSONResponse) VisitFCMTokenV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
 
-	// (DELETE /v1/rooms/{id})
-	RoomsV1Delete(ctx context.Context, request RoomsV1DeleteRequestObject) (RoomsV1DeleteResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (GET /v1/rooms/{id})
-	RoomsV1Detail(ctx context.Context, request RoomsV1DetailRequestObject) (RoomsV1DetailResponseObject, error)
+type FCMTokenV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
 
-	// (PUT /v1/rooms/{id})
-	RoomsV1Update(ctx context.Context, request RoomsV1UpdateRequestObject) (RoomsV1UpdateResponseObject, error)
+func (response FCMTokenV1ListdefaultApplicationProblemPlusJSONResponse) VisitFCMTokenV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
 
-	// (GET /v1/subjects)
-	SubjectsV1List(ctx context.Context, request SubjectsV1ListRequestObject) (SubjectsV1ListResponseObject, error)
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-	// (DELETE /v1/subjects/{id})
-	SubjectsV1Delete(ctx context.Context, request SubjectsV1DeleteRequestObject) (SubjectsV1DeleteResponseObject, error)
+type FCMTokenV1UpsertRequestObject struct {
+	Body *FCMTokenV1UpsertJSONRequestBody
+}
 
-	// (GET /v1/subjects/{id})
-	SubjectsV1Detail(ctx context.Context, request SubjectsV1DetailRequestObject) (SubjectsV1DetailResponseObject, error)
+type FCMTokenV1UpsertResponseObject interface {
+	VisitFCMTokenV1UpsertResponse(w http.ResponseWriter) error
+}
 
-	// (GET /v1/timetableItmes)
-	TimetableItemsV1List(ctx context.Context, request TimetableItemsV1ListRequestObject) (TimetableItemsV1ListResponseObject, error)
+type FCMTokenV1Upsert200JSONResponse struct {
+	FcmToken UserServiceFCMToken `json:"fcmToken"`
+}
 
-	// (POST /v1/timetableItmes)
-	TimetableItemsV1Create(ctx context.Context, request TimetableItemsV1CreateRequestObject) (TimetableItemsV1CreateResponseObject, error)
+func (response FCMTokenV1Upsert200JSONResponse) VisitFCMTokenV1UpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	// (DELETE /v1/timetableItmes/{id})
-	TimetableItemsV1Delete(ctx context.Context, request TimetableItemsV1DeleteRequestObject) (TimetableItemsV1DeleteResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
+}
 
-	// (GET /v1/users)
-	UsersV1List(ctx context.Context, request UsersV1ListRequestObject) (UsersV1ListResponseObject, error)
+type FCMTokenV1Upsert401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
 
-	// (GET /v1/users/{id})
-	UsersV1Detail(ctx context.Context, request UsersV1DetailRequestObject) (UsersV1DetailResponseObject, error)
+func (response FCMTokenV1Upsert401ApplicationProblemPlusJSONResponse) VisitFCMTokenV1UpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
 
-	// (POST /v1/users/{id})
-	UsersV1Upsert(ctx context.Context, request UsersV1UpsertRequestObject) (UsersV1UpsertResponseObject, error)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+type FCMTokenV1Upsert403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+func (response FCMTokenV1Upsert403ApplicationProblemPlusJSONResponse) VisitFCMTokenV1UpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FCMTokenV1UpsertdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response FCMTokenV1UpsertdefaultApplicationProblemPlusJSONResponse) VisitFCMTokenV1UpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MakeupClassesV1ListRequestObject struct {
+	Params MakeupClassesV1ListParams
+}
+
+type MakeupClassesV1ListResponseObject interface {
+	VisitMakeupClassesV1ListResponse(w http.ResponseWriter) error
+}
+
+type MakeupClassesV1List200JSONResponse struct {
+	MakeupClasses []AcademicServiceMakeupClass `json:"makeupClasses"`
+
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
+}
+
+func (response MakeupClassesV1List200JSONResponse) VisitMakeupClassesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MakeupClassesV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response MakeupClassesV1List400ApplicationProblemPlusJSONResponse) VisitMakeupClassesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MakeupClassesV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response MakeupClassesV1List401ApplicationProblemPlusJSONResponse) VisitMakeupClassesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MakeupClassesV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response MakeupClassesV1List403ApplicationProblemPlusJSONResponse) VisitMakeupClassesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MakeupClassesV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response MakeupClassesV1ListdefaultApplicationProblemPlusJSONResponse) VisitMakeupClassesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MakeupClassesV1CreateRequestObject struct {
+	Params MakeupClassesV1CreateParams
+	Body   *MakeupClassesV1CreateJSONRequestBody
+}
+
+type MakeupClassesV1CreateResponseObject interface {
+	VisitMakeupClassesV1CreateResponse(w http.ResponseWriter) error
+}
+
+type MakeupClassesV1Create201JSONResponse struct {
+	// MakeupClass 補講
+	MakeupClass AcademicServiceMakeupClass `json:"makeupClass"`
+
+	// NotificationError RFC 7807 Problem Details
+	NotificationError *Problem `json:"notificationError,omitempty"`
+
+	// NotificationId notify を指定した場合に作成した通知のID; 履修者がいない場合は省略する
+	NotificationId *string `json:"notificationId,omitempty"`
+}
+
+func (response MakeupClassesV1Create201JSONResponse) VisitMakeupClassesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MakeupClassesV1Create401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response MakeupClassesV1Create401ApplicationProblemPlusJSONResponse) VisitMakeupClassesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MakeupClassesV1Create403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response MakeupClassesV1Create403ApplicationProblemPlusJSONResponse) VisitMakeupClassesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MakeupClassesV1CreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response MakeupClassesV1CreatedefaultApplicationProblemPlusJSONResponse) VisitMakeupClassesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MakeupClassesV1DeleteRequestObject struct {
+	Id string `json:"id"`
+}
+
+type MakeupClassesV1DeleteResponseObject interface {
+	VisitMakeupClassesV1DeleteResponse(w http.ResponseWriter) error
+}
+
+type MakeupClassesV1Delete204Response struct {
+}
+
+func (response MakeupClassesV1Delete204Response) VisitMakeupClassesV1DeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type MakeupClassesV1Delete401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response MakeupClassesV1Delete401ApplicationProblemPlusJSONResponse) VisitMakeupClassesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MakeupClassesV1Delete403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response MakeupClassesV1Delete403ApplicationProblemPlusJSONResponse) VisitMakeupClassesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MakeupClassesV1Delete404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response MakeupClassesV1Delete404ApplicationProblemPlusJSONResponse) VisitMakeupClassesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MakeupClassesV1DeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response MakeupClassesV1DeletedefaultApplicationProblemPlusJSONResponse) VisitMakeupClassesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MenuItemsV1ListRequestObject struct {
+	Params MenuItemsV1ListParams
+}
+
+type MenuItemsV1ListResponseObject interface {
+	VisitMenuItemsV1ListResponse(w http.ResponseWriter) error
+}
+
+type MenuItemsV1List200JSONResponse struct {
+	MenuItems []FunchServiceMenuItem `json:"menuItems"`
+
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
+}
+
+func (response MenuItemsV1List200JSONResponse) VisitMenuItemsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MenuItemsV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response MenuItemsV1List400ApplicationProblemPlusJSONResponse) VisitMenuItemsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MenuItemsV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response MenuItemsV1List401ApplicationProblemPlusJSONResponse) VisitMenuItemsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MenuItemsV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response MenuItemsV1List403ApplicationProblemPlusJSONResponse) VisitMenuItemsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MenuItemsV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response MenuItemsV1ListdefaultApplicationProblemPlusJSONResponse) VisitMenuItemsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type NotificationTemplatesV1ListRequestObject struct {
+}
+
+type NotificationTemplatesV1ListResponseObject interface {
+	VisitNotificationTemplatesV1ListResponse(w http.ResponseWriter) error
+}
+
+type NotificationTemplatesV1List200JSONResponse struct {
+	Templates []NotificationTemplate `json:"templates"`
+}
+
+func (response NotificationTemplatesV1List200JSONResponse) VisitNotificationTemplatesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1List401ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1List403ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response NotificationTemplatesV1ListdefaultApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type NotificationTemplatesV1CreateRequestObject struct {
+	Body *NotificationTemplatesV1CreateJSONRequestBody
+}
+
+type NotificationTemplatesV1CreateResponseObject interface {
+	VisitNotificationTemplatesV1CreateResponse(w http.ResponseWriter) error
+}
+
+type NotificationTemplatesV1Create201JSONResponse struct {
+	// Template 通知テンプレート
+	//
+	// apnsBadge / apnsContentAvailable / androidTtlSeconds 以外の文字列の項目には `{name}` 形式のプレースホルダーを含めることができる
+	Template NotificationTemplate `json:"template"`
+}
+
+func (response NotificationTemplatesV1Create201JSONResponse) VisitNotificationTemplatesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1Create401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1Create401ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1Create403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1Create403ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1Create409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1Create409ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1CreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response NotificationTemplatesV1CreatedefaultApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type NotificationTemplatesV1DeleteRequestObject struct {
+	Id string `json:"id"`
+}
+
+type NotificationTemplatesV1DeleteResponseObject interface {
+	VisitNotificationTemplatesV1DeleteResponse(w http.ResponseWriter) error
+}
+
+type NotificationTemplatesV1Delete204Response struct {
+}
+
+func (response NotificationTemplatesV1Delete204Response) VisitNotificationTemplatesV1DeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type NotificationTemplatesV1Delete401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1Delete401ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1Delete403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1Delete403ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1Delete404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1Delete404ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1DeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response NotificationTemplatesV1DeletedefaultApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type NotificationTemplatesV1DetailRequestObject struct {
+	Id string `json:"id"`
+}
+
+type NotificationTemplatesV1DetailResponseObject interface {
+	VisitNotificationTemplatesV1DetailResponse(w http.ResponseWriter) error
+}
+
+type NotificationTemplatesV1Detail200JSONResponse struct {
+	// Template 通知テンプレート
+	//
+	// apnsBadge / apnsContentAvailable / androidTtlSeconds 以外の文字列の項目には `{name}` 形式のプレースホルダーを含めることができる
+	Template NotificationTemplate `json:"template"`
+}
+
+func (response NotificationTemplatesV1Detail200JSONResponse) VisitNotificationTemplatesV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1Detail401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1Detail401ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1Detail403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1Detail403ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1Detail404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1Detail404ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1DetaildefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response NotificationTemplatesV1DetaildefaultApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type NotificationTemplatesV1UpdateRequestObject struct {
+	Id   string `json:"id"`
+	Body *NotificationTemplatesV1UpdateJSONRequestBody
+}
+
+type NotificationTemplatesV1UpdateResponseObject interface {
+	VisitNotificationTemplatesV1UpdateResponse(w http.ResponseWriter) error
+}
+
+type NotificationTemplatesV1Update200JSONResponse struct {
+	// Template 通知テンプレート
+	//
+	// apnsBadge / apnsContentAvailable / androidTtlSeconds 以外の文字列の項目には `{name}` 形式のプレースホルダーを含めることができる
+	Template NotificationTemplate `json:"template"`
+}
+
+func (response NotificationTemplatesV1Update200JSONResponse) VisitNotificationTemplatesV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1Update401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1Update401ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1Update403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1Update403ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1Update404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1Update404ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1Update409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1Update409ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1UpdatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response NotificationTemplatesV1UpdatedefaultApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type NotificationTemplatesV1CreateNotificationRequestObject struct {
+	Id   string `json:"id"`
+	Body *NotificationTemplatesV1CreateNotificationJSONRequestBody
+}
+
+type NotificationTemplatesV1CreateNotificationResponseObject interface {
+	VisitNotificationTemplatesV1CreateNotificationResponse(w http.ResponseWriter) error
+}
+
+type NotificationTemplatesV1CreateNotification201JSONResponse struct {
+	Notification UserServiceNotification `json:"notification"`
+}
+
+func (response NotificationTemplatesV1CreateNotification201JSONResponse) VisitNotificationTemplatesV1CreateNotificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1CreateNotification400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1CreateNotification400ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1CreateNotificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1CreateNotification401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1CreateNotification401ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1CreateNotificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1CreateNotification403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1CreateNotification403ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1CreateNotificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1CreateNotification404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1CreateNotification404ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1CreateNotificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1CreateNotification422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableEntityApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationTemplatesV1CreateNotification422ApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1CreateNotificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationTemplatesV1CreateNotificationdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response NotificationTemplatesV1CreateNotificationdefaultApplicationProblemPlusJSONResponse) VisitNotificationTemplatesV1CreateNotificationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type NotificationV1ListRequestObject struct {
+	Params NotificationV1ListParams
+}
+
+type NotificationV1ListResponseObject interface {
+	VisitNotificationV1ListResponse(w http.ResponseWriter) error
+}
+
+type NotificationV1List200JSONResponse struct {
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor    *string                   `json:"nextCursor,omitempty"`
+	Notifications []UserServiceNotification `json:"notifications"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
+}
+
+func (response NotificationV1List200JSONResponse) VisitNotificationV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1List400ApplicationProblemPlusJSONResponse) VisitNotificationV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1List401ApplicationProblemPlusJSONResponse) VisitNotificationV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1List403ApplicationProblemPlusJSONResponse) VisitNotificationV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response NotificationV1ListdefaultApplicationProblemPlusJSONResponse) VisitNotificationV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type NotificationV1CreateRequestObject struct {
+	Body *NotificationV1CreateJSONRequestBody
+}
+
+type NotificationV1CreateResponseObject interface {
+	VisitNotificationV1CreateResponse(w http.ResponseWriter) error
+}
+
+type NotificationV1Create201JSONResponse struct {
+	Notification UserServiceNotification `json:"notification"`
+}
+
+func (response NotificationV1Create201JSONResponse) VisitNotificationV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Create400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Create400ApplicationProblemPlusJSONResponse) VisitNotificationV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Create401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Create401ApplicationProblemPlusJSONResponse) VisitNotificationV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Create403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Create403ApplicationProblemPlusJSONResponse) VisitNotificationV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1CreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response NotificationV1CreatedefaultApplicationProblemPlusJSONResponse) VisitNotificationV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type NotificationV1CreateForAudienceRequestObject struct {
+	Body *NotificationV1CreateForAudienceJSONRequestBody
+}
+
+type NotificationV1CreateForAudienceResponseObject interface {
+	VisitNotificationV1CreateForAudienceResponse(w http.ResponseWriter) error
+}
+
+type NotificationV1CreateForAudience201JSONResponse struct {
+	Notification UserServiceNotification `json:"notification"`
+
+	// UserCount 対象ユーザー数
+	UserCount int `json:"userCount"`
+}
+
+func (response NotificationV1CreateForAudience201JSONResponse) VisitNotificationV1CreateForAudienceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1CreateForAudience400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1CreateForAudience400ApplicationProblemPlusJSONResponse) VisitNotificationV1CreateForAudienceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1CreateForAudience401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1CreateForAudience401ApplicationProblemPlusJSONResponse) VisitNotificationV1CreateForAudienceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1CreateForAudience403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1CreateForAudience403ApplicationProblemPlusJSONResponse) VisitNotificationV1CreateForAudienceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1CreateForAudience422ApplicationProblemPlusJSONResponse struct {
+	UnprocessableEntityApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1CreateForAudience422ApplicationProblemPlusJSONResponse) VisitNotificationV1CreateForAudienceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1CreateForAudiencedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response NotificationV1CreateForAudiencedefaultApplicationProblemPlusJSONResponse) VisitNotificationV1CreateForAudienceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type NotificationV1PreviewAudienceRequestObject struct {
+	Body *NotificationV1PreviewAudienceJSONRequestBody
+}
+
+type NotificationV1PreviewAudienceResponseObject interface {
+	VisitNotificationV1PreviewAudienceResponse(w http.ResponseWriter) error
+}
+
+type NotificationV1PreviewAudience200JSONResponse struct {
+	// UserCount 対象ユーザー数
+	UserCount int `json:"userCount"`
+}
+
+func (response NotificationV1PreviewAudience200JSONResponse) VisitNotificationV1PreviewAudienceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1PreviewAudience400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1PreviewAudience400ApplicationProblemPlusJSONResponse) VisitNotificationV1PreviewAudienceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1PreviewAudience401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1PreviewAudience401ApplicationProblemPlusJSONResponse) VisitNotificationV1PreviewAudienceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1PreviewAudience403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1PreviewAudience403ApplicationProblemPlusJSONResponse) VisitNotificationV1PreviewAudienceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1PreviewAudiencedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response NotificationV1PreviewAudiencedefaultApplicationProblemPlusJSONResponse) VisitNotificationV1PreviewAudienceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type NotificationV1DispatchRequestObject struct {
+	Params NotificationV1DispatchParams
+	Body   *NotificationV1DispatchJSONRequestBody
+}
+
+type NotificationV1DispatchResponseObject interface {
+	VisitNotificationV1DispatchResponse(w http.ResponseWriter) error
+}
+
+type NotificationV1Dispatch200JSONResponse struct {
+	// MissingNotificationIds dryRun の場合のみ; 指定したIDのうち存在しない通知のID
+	MissingNotificationIds *[]string                 `json:"missingNotificationIds,omitempty"`
+	Notifications          []UserServiceNotification `json:"notifications"`
+
+	// ReachableUserCount dryRun の場合のみ; 対象ユーザーのうち FCMトークンを 1 つ以上持つユーザー数
+	ReachableUserCount *int `json:"reachableUserCount,omitempty"`
+
+	// TargetUserIds dryRun の場合のみ; 送信される通知の対象ユーザーIDを重複なく昇順に並べたもの
+	TargetUserIds *[]string `json:"targetUserIds,omitempty"`
+}
+
+func (response NotificationV1Dispatch200JSONResponse) VisitNotificationV1DispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Dispatch400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Dispatch400ApplicationProblemPlusJSONResponse) VisitNotificationV1DispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Dispatch401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Dispatch401ApplicationProblemPlusJSONResponse) VisitNotificationV1DispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Dispatch403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Dispatch403ApplicationProblemPlusJSONResponse) VisitNotificationV1DispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1DispatchdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response NotificationV1DispatchdefaultApplicationProblemPlusJSONResponse) VisitNotificationV1DispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type NotificationV1LifecycleRequestObject struct {
+	Params NotificationV1LifecycleParams
+}
+
+type NotificationV1LifecycleResponseObject interface {
+	VisitNotificationV1LifecycleResponse(w http.ResponseWriter) error
+}
+
+type NotificationV1Lifecycle200JSONResponse struct {
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor    *string                 `json:"nextCursor,omitempty"`
+	Notifications []NotificationLifecycle `json:"notifications"`
+
+	// StatusCounts 配信状況ごとの通知の件数; statuses による絞り込み前の件数
+	StatusCounts map[string]int `json:"statusCounts"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
+}
+
+func (response NotificationV1Lifecycle200JSONResponse) VisitNotificationV1LifecycleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Lifecycle400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Lifecycle400ApplicationProblemPlusJSONResponse) VisitNotificationV1LifecycleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Lifecycle401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Lifecycle401ApplicationProblemPlusJSONResponse) VisitNotificationV1LifecycleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Lifecycle403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Lifecycle403ApplicationProblemPlusJSONResponse) VisitNotificationV1LifecycleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1LifecycledefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response NotificationV1LifecycledefaultApplicationProblemPlusJSONResponse) VisitNotificationV1LifecycleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type NotificationV1DeleteRequestObject struct {
+	Id string `json:"id"`
+}
+
+type NotificationV1DeleteResponseObject interface {
+	VisitNotificationV1DeleteResponse(w http.ResponseWriter) error
+}
+
+type NotificationV1Delete204Response struct {
+}
+
+func (response NotificationV1Delete204Response) VisitNotificationV1DeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type NotificationV1Delete401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Delete401ApplicationProblemPlusJSONResponse) VisitNotificationV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Delete403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Delete403ApplicationProblemPlusJSONResponse) VisitNotificationV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Delete404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Delete404ApplicationProblemPlusJSONResponse) VisitNotificationV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1DeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response NotificationV1DeletedefaultApplicationProblemPlusJSONResponse) VisitNotificationV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type NotificationV1DetailRequestObject struct {
+	Id string `json:"id"`
+}
+
+type NotificationV1DetailResponseObject interface {
+	VisitNotificationV1DetailResponse(w http.ResponseWriter) error
+}
+
+type NotificationV1Detail200JSONResponse struct {
+	Notification UserServiceNotification `json:"notification"`
+}
+
+func (response NotificationV1Detail200JSONResponse) VisitNotificationV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Detail401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Detail401ApplicationProblemPlusJSONResponse) VisitNotificationV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Detail403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Detail403ApplicationProblemPlusJSONResponse) VisitNotificationV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Detail404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Detail404ApplicationProblemPlusJSONResponse) VisitNotificationV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1DetaildefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response NotificationV1DetaildefaultApplicationProblemPlusJSONResponse) VisitNotificationV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type NotificationV1UpdateRequestObject struct {
+	Id     string `json:"id"`
+	Params NotificationV1UpdateParams
+	Body   *NotificationV1UpdateJSONRequestBody
+}
+
+type NotificationV1UpdateResponseObject interface {
+	VisitNotificationV1UpdateResponse(w http.ResponseWriter) error
+}
+
+type NotificationV1Update200JSONResponse struct {
+	Notification UserServiceNotification `json:"notification"`
+}
+
+func (response NotificationV1Update200JSONResponse) VisitNotificationV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Update400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Update400ApplicationProblemPlusJSONResponse) VisitNotificationV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Update401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Update401ApplicationProblemPlusJSONResponse) VisitNotificationV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Update403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Update403ApplicationProblemPlusJSONResponse) VisitNotificationV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Update404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Update404ApplicationProblemPlusJSONResponse) VisitNotificationV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1Update412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response NotificationV1Update412ApplicationProblemPlusJSONResponse) VisitNotificationV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type NotificationV1UpdatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response NotificationV1UpdatedefaultApplicationProblemPlusJSONResponse) VisitNotificationV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PersonalCalendarItemsV1ListRequestObject struct {
+	Params PersonalCalendarItemsV1ListParams
+}
+
+type PersonalCalendarItemsV1ListResponseObject interface {
+	VisitPersonalCalendarItemsV1ListResponse(w http.ResponseWriter) error
+}
+
+type PersonalCalendarItemsV1List200JSONResponse struct {
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor            *string                               `json:"nextCursor,omitempty"`
+	PersonalCalendarItems []AcademicServicePersonalCalendarItem `json:"personalCalendarItems"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
+}
+
+func (response PersonalCalendarItemsV1List200JSONResponse) VisitPersonalCalendarItemsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PersonalCalendarItemsV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response PersonalCalendarItemsV1List400ApplicationProblemPlusJSONResponse) VisitPersonalCalendarItemsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PersonalCalendarItemsV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PersonalCalendarItemsV1List401ApplicationProblemPlusJSONResponse) VisitPersonalCalendarItemsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PersonalCalendarItemsV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PersonalCalendarItemsV1List403ApplicationProblemPlusJSONResponse) VisitPersonalCalendarItemsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PersonalCalendarItemsV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response PersonalCalendarItemsV1ListdefaultApplicationProblemPlusJSONResponse) VisitPersonalCalendarItemsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReservationsV1ListRequestObject struct {
+	Params ReservationsV1ListParams
+}
+
+type ReservationsV1ListResponseObject interface {
+	VisitReservationsV1ListResponse(w http.ResponseWriter) error
+}
+
+type ReservationsV1List200JSONResponse struct {
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor   *string                      `json:"nextCursor,omitempty"`
+	Reservations []AcademicServiceReservation `json:"reservations"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
+}
+
+func (response ReservationsV1List200JSONResponse) VisitReservationsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReservationsV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ReservationsV1List400ApplicationProblemPlusJSONResponse) VisitReservationsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReservationsV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ReservationsV1List401ApplicationProblemPlusJSONResponse) VisitReservationsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReservationsV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ReservationsV1List403ApplicationProblemPlusJSONResponse) VisitReservationsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReservationsV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response ReservationsV1ListdefaultApplicationProblemPlusJSONResponse) VisitReservationsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReservationsV1CreateRequestObject struct {
+	Body *ReservationsV1CreateJSONRequestBody
+}
+
+type ReservationsV1CreateResponseObject interface {
+	VisitReservationsV1CreateResponse(w http.ResponseWriter) error
+}
+
+type ReservationsV1Create201JSONResponse struct {
+	Reservation AcademicServiceReservation `json:"reservation"`
+}
+
+func (response ReservationsV1Create201JSONResponse) VisitReservationsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReservationsV1Create401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ReservationsV1Create401ApplicationProblemPlusJSONResponse) VisitReservationsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReservationsV1Create403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ReservationsV1Create403ApplicationProblemPlusJSONResponse) VisitReservationsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReservationsV1CreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response ReservationsV1CreatedefaultApplicationProblemPlusJSONResponse) VisitReservationsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ReservationsV1DeleteRequestObject struct {
+	Id string `json:"id"`
+}
+
+type ReservationsV1DeleteResponseObject interface {
+	VisitReservationsV1DeleteResponse(w http.ResponseWriter) error
+}
+
+type ReservationsV1Delete204Response struct {
+}
+
+func (response ReservationsV1Delete204Response) VisitReservationsV1DeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ReservationsV1Delete401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ReservationsV1Delete401ApplicationProblemPlusJSONResponse) VisitReservationsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReservationsV1Delete403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ReservationsV1Delete403ApplicationProblemPlusJSONResponse) VisitReservationsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReservationsV1Delete404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response ReservationsV1Delete404ApplicationProblemPlusJSONResponse) VisitReservationsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReservationsV1DeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response ReservationsV1DeletedefaultApplicationProblemPlusJSONResponse) VisitReservationsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RoomChangesV1ListRequestObject struct {
+	Params RoomChangesV1ListParams
+}
+
+type RoomChangesV1ListResponseObject interface {
+	VisitRoomChangesV1ListResponse(w http.ResponseWriter) error
+}
+
+type RoomChangesV1List200JSONResponse struct {
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor  *string                     `json:"nextCursor,omitempty"`
+	RoomChanges []AcademicServiceRoomChange `json:"roomChanges"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
+}
+
+func (response RoomChangesV1List200JSONResponse) VisitRoomChangesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomChangesV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response RoomChangesV1List400ApplicationProblemPlusJSONResponse) VisitRoomChangesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomChangesV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RoomChangesV1List401ApplicationProblemPlusJSONResponse) VisitRoomChangesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomChangesV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RoomChangesV1List403ApplicationProblemPlusJSONResponse) VisitRoomChangesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomChangesV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response RoomChangesV1ListdefaultApplicationProblemPlusJSONResponse) VisitRoomChangesV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RoomChangesV1CreateRequestObject struct {
+	Params RoomChangesV1CreateParams
+	Body   *RoomChangesV1CreateJSONRequestBody
+}
+
+type RoomChangesV1CreateResponseObject interface {
+	VisitRoomChangesV1CreateResponse(w http.ResponseWriter) error
+}
+
+type RoomChangesV1Create201JSONResponse struct {
+	// NotificationError RFC 7807 Problem Details
+	NotificationError *Problem `json:"notificationError,omitempty"`
+
+	// NotificationId notify を指定した場合に作成した通知のID; 履修者がいない場合は省略する
+	NotificationId *string `json:"notificationId,omitempty"`
+
+	// RoomChange 教室変更
+	RoomChange AcademicServiceRoomChange `json:"roomChange"`
+}
+
+func (response RoomChangesV1Create201JSONResponse) VisitRoomChangesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomChangesV1Create401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RoomChangesV1Create401ApplicationProblemPlusJSONResponse) VisitRoomChangesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomChangesV1Create403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RoomChangesV1Create403ApplicationProblemPlusJSONResponse) VisitRoomChangesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomChangesV1CreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response RoomChangesV1CreatedefaultApplicationProblemPlusJSONResponse) VisitRoomChangesV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RoomChangesV1DeleteRequestObject struct {
+	Id string `json:"id"`
+}
+
+type RoomChangesV1DeleteResponseObject interface {
+	VisitRoomChangesV1DeleteResponse(w http.ResponseWriter) error
+}
+
+type RoomChangesV1Delete204Response struct {
+}
+
+func (response RoomChangesV1Delete204Response) VisitRoomChangesV1DeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RoomChangesV1Delete401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RoomChangesV1Delete401ApplicationProblemPlusJSONResponse) VisitRoomChangesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomChangesV1Delete403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RoomChangesV1Delete403ApplicationProblemPlusJSONResponse) VisitRoomChangesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomChangesV1Delete404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response RoomChangesV1Delete404ApplicationProblemPlusJSONResponse) VisitRoomChangesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomChangesV1DeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response RoomChangesV1DeletedefaultApplicationProblemPlusJSONResponse) VisitRoomChangesV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RoomsV1ListRequestObject struct {
+	Params RoomsV1ListParams
+}
+
+type RoomsV1ListResponseObject interface {
+	VisitRoomsV1ListResponse(w http.ResponseWriter) error
+}
+
+type RoomsV1List200JSONResponse struct {
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor *string               `json:"nextCursor,omitempty"`
+	Rooms      []AcademicServiceRoom `json:"rooms"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
+}
+
+func (response RoomsV1List200JSONResponse) VisitRoomsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1List400ApplicationProblemPlusJSONResponse) VisitRoomsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1List401ApplicationProblemPlusJSONResponse) VisitRoomsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1List403ApplicationProblemPlusJSONResponse) VisitRoomsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response RoomsV1ListdefaultApplicationProblemPlusJSONResponse) VisitRoomsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RoomsV1CreateRequestObject struct {
+	Body *RoomsV1CreateJSONRequestBody
+}
+
+type RoomsV1CreateResponseObject interface {
+	VisitRoomsV1CreateResponse(w http.ResponseWriter) error
+}
+
+type RoomsV1Create201JSONResponse struct {
+	Room AcademicServiceRoom `json:"room"`
+}
+
+func (response RoomsV1Create201JSONResponse) VisitRoomsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1Create401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1Create401ApplicationProblemPlusJSONResponse) VisitRoomsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1Create403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1Create403ApplicationProblemPlusJSONResponse) VisitRoomsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1CreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response RoomsV1CreatedefaultApplicationProblemPlusJSONResponse) VisitRoomsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RoomsV1DeleteRequestObject struct {
+	Id string `json:"id"`
+}
+
+type RoomsV1DeleteResponseObject interface {
+	VisitRoomsV1DeleteResponse(w http.ResponseWriter) error
+}
+
+type RoomsV1Delete204Response struct {
+}
+
+func (response RoomsV1Delete204Response) VisitRoomsV1DeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RoomsV1Delete401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1Delete401ApplicationProblemPlusJSONResponse) VisitRoomsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1Delete403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1Delete403ApplicationProblemPlusJSONResponse) VisitRoomsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1Delete404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1Delete404ApplicationProblemPlusJSONResponse) VisitRoomsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1Delete409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1Delete409ApplicationProblemPlusJSONResponse) VisitRoomsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1DeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response RoomsV1DeletedefaultApplicationProblemPlusJSONResponse) VisitRoomsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RoomsV1DetailRequestObject struct {
+	Id string `json:"id"`
+}
+
+type RoomsV1DetailResponseObject interface {
+	VisitRoomsV1DetailResponse(w http.ResponseWriter) error
+}
+
+type RoomsV1Detail200JSONResponse struct {
+	Room AcademicServiceRoom `json:"room"`
+}
+
+func (response RoomsV1Detail200JSONResponse) VisitRoomsV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1Detail401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1Detail401ApplicationProblemPlusJSONResponse) VisitRoomsV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1Detail403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1Detail403ApplicationProblemPlusJSONResponse) VisitRoomsV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1Detail404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1Detail404ApplicationProblemPlusJSONResponse) VisitRoomsV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1DetaildefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response RoomsV1DetaildefaultApplicationProblemPlusJSONResponse) VisitRoomsV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RoomsV1UpdateRequestObject struct {
+	Id     string `json:"id"`
+	Params RoomsV1UpdateParams
+	Body   *RoomsV1UpdateJSONRequestBody
+}
+
+type RoomsV1UpdateResponseObject interface {
+	VisitRoomsV1UpdateResponse(w http.ResponseWriter) error
+}
+
+type RoomsV1Update200JSONResponse struct {
+	Room AcademicServiceRoom `json:"room"`
+}
+
+func (response RoomsV1Update200JSONResponse) VisitRoomsV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1Update401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1Update401ApplicationProblemPlusJSONResponse) VisitRoomsV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1Update403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1Update403ApplicationProblemPlusJSONResponse) VisitRoomsV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1Update404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1Update404ApplicationProblemPlusJSONResponse) VisitRoomsV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1Update412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response RoomsV1Update412ApplicationProblemPlusJSONResponse) VisitRoomsV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RoomsV1UpdatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response RoomsV1UpdatedefaultApplicationProblemPlusJSONResponse) VisitRoomsV1UpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SubjectsV1ListRequestObject struct {
+	Params SubjectsV1ListParams
+}
+
+type SubjectsV1ListResponseObject interface {
+	VisitSubjectsV1ListResponse(w http.ResponseWriter) error
+}
+
+type SubjectsV1List200JSONResponse struct {
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor *string                  `json:"nextCursor,omitempty"`
+	Subjects   []AcademicServiceSubject `json:"subjects"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
+}
+
+func (response SubjectsV1List200JSONResponse) VisitSubjectsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubjectsV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response SubjectsV1List400ApplicationProblemPlusJSONResponse) VisitSubjectsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubjectsV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response SubjectsV1List401ApplicationProblemPlusJSONResponse) VisitSubjectsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubjectsV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response SubjectsV1List403ApplicationProblemPlusJSONResponse) VisitSubjectsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubjectsV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response SubjectsV1ListdefaultApplicationProblemPlusJSONResponse) VisitSubjectsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SubjectsV1DeleteRequestObject struct {
+	Id string `json:"id"`
+}
+
+type SubjectsV1DeleteResponseObject interface {
+	VisitSubjectsV1DeleteResponse(w http.ResponseWriter) error
+}
+
+type SubjectsV1Delete204Response struct {
+}
+
+func (response SubjectsV1Delete204Response) VisitSubjectsV1DeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type SubjectsV1Delete401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response SubjectsV1Delete401ApplicationProblemPlusJSONResponse) VisitSubjectsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubjectsV1Delete403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response SubjectsV1Delete403ApplicationProblemPlusJSONResponse) VisitSubjectsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubjectsV1Delete404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response SubjectsV1Delete404ApplicationProblemPlusJSONResponse) VisitSubjectsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubjectsV1Delete409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response SubjectsV1Delete409ApplicationProblemPlusJSONResponse) VisitSubjectsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubjectsV1DeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response SubjectsV1DeletedefaultApplicationProblemPlusJSONResponse) VisitSubjectsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SubjectsV1DetailRequestObject struct {
+	Id string `json:"id"`
+}
+
+type SubjectsV1DetailResponseObject interface {
+	VisitSubjectsV1DetailResponse(w http.ResponseWriter) error
+}
+
+type SubjectsV1Detail200JSONResponse struct {
+	Subject AcademicServiceSubject `json:"subject"`
+}
+
+func (response SubjectsV1Detail200JSONResponse) VisitSubjectsV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubjectsV1Detail401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response SubjectsV1Detail401ApplicationProblemPlusJSONResponse) VisitSubjectsV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubjectsV1Detail403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response SubjectsV1Detail403ApplicationProblemPlusJSONResponse) VisitSubjectsV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubjectsV1Detail404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response SubjectsV1Detail404ApplicationProblemPlusJSONResponse) VisitSubjectsV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubjectsV1DetaildefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response SubjectsV1DetaildefaultApplicationProblemPlusJSONResponse) VisitSubjectsV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SyllabusV1DetailRequestObject struct {
+	Id string `json:"id"`
+}
+
+type SyllabusV1DetailResponseObject interface {
+	VisitSyllabusV1DetailResponse(w http.ResponseWriter) error
+}
+
+type SyllabusV1Detail200JSONResponse struct {
+	Syllabus AcademicServiceSyllabus `json:"syllabus"`
+}
+
+func (response SyllabusV1Detail200JSONResponse) VisitSyllabusV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyllabusV1Detail401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response SyllabusV1Detail401ApplicationProblemPlusJSONResponse) VisitSyllabusV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyllabusV1Detail403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response SyllabusV1Detail403ApplicationProblemPlusJSONResponse) VisitSyllabusV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyllabusV1Detail404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response SyllabusV1Detail404ApplicationProblemPlusJSONResponse) VisitSyllabusV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyllabusV1DetaildefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response SyllabusV1DetaildefaultApplicationProblemPlusJSONResponse) VisitSyllabusV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type TimetableItemsV1ListRequestObject struct {
+	Params TimetableItemsV1ListParams
+}
+
+type TimetableItemsV1ListResponseObject interface {
+	VisitTimetableItemsV1ListResponse(w http.ResponseWriter) error
+}
+
+type TimetableItemsV1List200JSONResponse struct {
+	Items []AcademicServiceTimetableItem `json:"items"`
+
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int `json:"totalCount"`
+}
+
+func (response TimetableItemsV1List200JSONResponse) VisitTimetableItemsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TimetableItemsV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response TimetableItemsV1List400ApplicationProblemPlusJSONResponse) VisitTimetableItemsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TimetableItemsV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response TimetableItemsV1List401ApplicationProblemPlusJSONResponse) VisitTimetableItemsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TimetableItemsV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response TimetableItemsV1List403ApplicationProblemPlusJSONResponse) VisitTimetableItemsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TimetableItemsV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response TimetableItemsV1ListdefaultApplicationProblemPlusJSONResponse) VisitTimetableItemsV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type TimetableItemsV1CreateRequestObject struct {
+	Body *TimetableItemsV1CreateJSONRequestBody
+}
+
+type TimetableItemsV1CreateResponseObject interface {
+	VisitTimetableItemsV1CreateResponse(w http.ResponseWriter) error
+}
+
+type TimetableItemsV1Create201JSONResponse struct {
+	Item AcademicServiceTimetableItem `json:"item"`
+}
+
+func (response TimetableItemsV1Create201JSONResponse) VisitTimetableItemsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TimetableItemsV1Create401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response TimetableItemsV1Create401ApplicationProblemPlusJSONResponse) VisitTimetableItemsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TimetableItemsV1Create403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response TimetableItemsV1Create403ApplicationProblemPlusJSONResponse) VisitTimetableItemsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TimetableItemsV1CreatedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response TimetableItemsV1CreatedefaultApplicationProblemPlusJSONResponse) VisitTimetableItemsV1CreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type TimetableItemsV1DeleteRequestObject struct {
+	Id string `json:"id"`
+}
+
+type TimetableItemsV1DeleteResponseObject interface {
+	VisitTimetableItemsV1DeleteResponse(w http.ResponseWriter) error
+}
+
+type TimetableItemsV1Delete204Response struct {
+}
+
+func (response TimetableItemsV1Delete204Response) VisitTimetableItemsV1DeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type TimetableItemsV1Delete401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response TimetableItemsV1Delete401ApplicationProblemPlusJSONResponse) VisitTimetableItemsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TimetableItemsV1Delete403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response TimetableItemsV1Delete403ApplicationProblemPlusJSONResponse) VisitTimetableItemsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TimetableItemsV1Delete404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response TimetableItemsV1Delete404ApplicationProblemPlusJSONResponse) VisitTimetableItemsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TimetableItemsV1DeletedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response TimetableItemsV1DeletedefaultApplicationProblemPlusJSONResponse) VisitTimetableItemsV1DeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersV1ListRequestObject struct {
+	Params UsersV1ListParams
+}
+
+type UsersV1ListResponseObject interface {
+	VisitUsersV1ListResponse(w http.ResponseWriter) error
+}
+
+type UsersV1List200JSONResponse struct {
+	// Facets ユーザー検索の絞り込み条件ごとの件数
+	//
+	// 各項目の件数は、その項目以外の絞り込み条件に一致するユーザーを値ごとに数えたもの
+	// 値が未設定のユーザーは数えない
+	Facets UserFacets `json:"facets"`
+
+	// NextCursor 次のページを取得するためのカーソル; 次のページがない場合は含まれない
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// TotalCount 絞り込み後の総件数
+	TotalCount int               `json:"totalCount"`
+	Users      []UserServiceUser `json:"users"`
+}
+
+func (response UsersV1List200JSONResponse) VisitUsersV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersV1List400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response UsersV1List400ApplicationProblemPlusJSONResponse) VisitUsersV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersV1List401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response UsersV1List401ApplicationProblemPlusJSONResponse) VisitUsersV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersV1List403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UsersV1List403ApplicationProblemPlusJSONResponse) VisitUsersV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersV1ListdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response UsersV1ListdefaultApplicationProblemPlusJSONResponse) VisitUsersV1ListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersV1DetailRequestObject struct {
+	Id string `json:"id"`
+}
+
+type UsersV1DetailResponseObject interface {
+	VisitUsersV1DetailResponse(w http.ResponseWriter) error
+}
+
+type UsersV1Detail200JSONResponse struct {
+	User UserServiceUser `json:"user"`
+}
+
+func (response UsersV1Detail200JSONResponse) VisitUsersV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersV1Detail401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response UsersV1Detail401ApplicationProblemPlusJSONResponse) VisitUsersV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersV1Detail403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UsersV1Detail403ApplicationProblemPlusJSONResponse) VisitUsersV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersV1Detail404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response UsersV1Detail404ApplicationProblemPlusJSONResponse) VisitUsersV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersV1DetaildefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response UsersV1DetaildefaultApplicationProblemPlusJSONResponse) VisitUsersV1DetailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UsersV1UpsertRequestObject struct {
+	Id   string `json:"id"`
+	Body *UsersV1UpsertJSONRequestBody
+}
+
+type UsersV1UpsertResponseObject interface {
+	VisitUsersV1UpsertResponse(w http.ResponseWriter) error
+}
+
+type UsersV1Upsert200JSONResponse struct {
+	User UserServiceUser `json:"user"`
+}
+
+func (response UsersV1Upsert200JSONResponse) VisitUsersV1UpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersV1Upsert401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response UsersV1Upsert401ApplicationProblemPlusJSONResponse) VisitUsersV1UpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersV1Upsert403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UsersV1Upsert403ApplicationProblemPlusJSONResponse) VisitUsersV1UpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UsersV1UpsertdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Problem
+	StatusCode int
+}
+
+func (response UsersV1UpsertdefaultApplicationProblemPlusJSONResponse) VisitUsersV1UpsertResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /v1/announcements)
+	AnnouncementsV1List(ctx context.Context, request AnnouncementsV1ListRequestObject) (AnnouncementsV1ListResponseObject, error)
+
+	// (POST /v1/announcements)
+	AnnouncementsV1Create(ctx context.Context, request AnnouncementsV1CreateRequestObject) (AnnouncementsV1CreateResponseObject, error)
+
+	// (DELETE /v1/announcements/{id})
+	AnnouncementsV1Delete(ctx context.Context, request AnnouncementsV1DeleteRequestObject) (AnnouncementsV1DeleteResponseObject, error)
+
+	// (GET /v1/announcements/{id})
+	AnnouncementsV1Detail(ctx context.Context, request AnnouncementsV1DetailRequestObject) (AnnouncementsV1DetailResponseObject, error)
+
+	// (PUT /v1/announcements/{id})
+	AnnouncementsV1Update(ctx context.Context, request AnnouncementsV1UpdateRequestObject) (AnnouncementsV1UpdateResponseObject, error)
+
+	// (GET /v1/auditLogs)
+	AuditLogsV1List(ctx context.Context, request AuditLogsV1ListRequestObject) (AuditLogsV1ListResponseObject, error)
+
+	// (DELETE /v1/cache)
+	CacheV1Flush(ctx context.Context, request CacheV1FlushRequestObject) (CacheV1FlushResponseObject, error)
+
+	// (GET /v1/cancelledClasses)
+	CancelledClassesV1List(ctx context.Context, request CancelledClassesV1ListRequestObject) (CancelledClassesV1ListResponseObject, error)
+
+	// (POST /v1/cancelledClasses)
+	CancelledClassesV1Create(ctx context.Context, request CancelledClassesV1CreateRequestObject) (CancelledClassesV1CreateResponseObject, error)
+
+	// (DELETE /v1/cancelledClasses/{id})
+	CancelledClassesV1Delete(ctx context.Context, request CancelledClassesV1DeleteRequestObject) (CancelledClassesV1DeleteResponseObject, error)
+
+	// (GET /v1/courseRegistrations)
+	CourseRegistrationsV1List(ctx context.Context, request CourseRegistrationsV1ListRequestObject) (CourseRegistrationsV1ListResponseObject, error)
+
+	// (POST /v1/courseRegistrations)
+	CourseRegistrationsV1Create(ctx context.Context, request CourseRegistrationsV1CreateRequestObject) (CourseRegistrationsV1CreateResponseObject, error)
+
+	// (DELETE /v1/courseRegistrations/{id})
+	CourseRegistrationsV1Delete(ctx context.Context, request CourseRegistrationsV1DeleteRequestObject) (CourseRegistrationsV1DeleteResponseObject, error)
+
+	// (GET /v1/faculties)
+	FacultiesV1List(ctx context.Context, request FacultiesV1ListRequestObject) (FacultiesV1ListResponseObject, error)
+
+	// (POST /v1/faculties)
+	FacultiesV1Create(ctx context.Context, request FacultiesV1CreateRequestObject) (FacultiesV1CreateResponseObject, error)
+
+	// (DELETE /v1/faculties/{id})
+	FacultiesV1Delete(ctx context.Context, request FacultiesV1DeleteRequestObject) (FacultiesV1DeleteResponseObject, error)
+
+	// (GET /v1/faculties/{id})
+	FacultiesV1Detail(ctx context.Context, request FacultiesV1DetailRequestObject) (FacultiesV1DetailResponseObject, error)
+
+	// (PUT /v1/faculties/{id})
+	FacultiesV1Update(ctx context.Context, request FacultiesV1UpdateRequestObject) (FacultiesV1UpdateResponseObject, error)
+
+	// (GET /v1/facultyRooms)
+	FacultyRoomsV1List(ctx context.Context, request FacultyRoomsV1ListRequestObject) (FacultyRoomsV1ListResponseObject, error)
+
+	// (POST /v1/facultyRooms)
+	FacultyRoomsV1Create(ctx context.Context, request FacultyRoomsV1CreateRequestObject) (FacultyRoomsV1CreateResponseObject, error)
+
+	// (DELETE /v1/facultyRooms/{id})
+	FacultyRoomsV1Delete(ctx context.Context, request FacultyRoomsV1DeleteRequestObject) (FacultyRoomsV1DeleteResponseObject, error)
+
+	// (GET /v1/fcmTokens)
+	FCMTokenV1List(ctx context.Context, request FCMTokenV1ListRequestObject) (FCMTokenV1ListResponseObject, error)
+
+	// (POST /v1/fcmTokens)
+	FCMTokenV1Upsert(ctx context.Context, request FCMTokenV1UpsertRequestObject) (FCMTokenV1UpsertResponseObject, error)
+
+	// (GET /v1/makeupClasses)
+	MakeupClassesV1List(ctx context.Context, request MakeupClassesV1ListRequestObject) (MakeupClassesV1ListResponseObject, error)
+
+	// (POST /v1/makeupClasses)
+	MakeupClassesV1Create(ctx context.Context, request MakeupClassesV1CreateRequestObject) (MakeupClassesV1CreateResponseObject, error)
+
+	// (DELETE /v1/makeupClasses/{id})
+	MakeupClassesV1Delete(ctx context.Context, request MakeupClassesV1DeleteRequestObject) (MakeupClassesV1DeleteResponseObject, error)
+
+	// (GET /v1/menuItems)
+	MenuItemsV1List(ctx context.Context, request MenuItemsV1ListRequestObject) (MenuItemsV1ListResponseObject, error)
+
+	// (GET /v1/notificationTemplates)
+	NotificationTemplatesV1List(ctx context.Context, request NotificationTemplatesV1ListRequestObject) (NotificationTemplatesV1ListResponseObject, error)
+
+	// (POST /v1/notificationTemplates)
+	NotificationTemplatesV1Create(ctx context.Context, request NotificationTemplatesV1CreateRequestObject) (NotificationTemplatesV1CreateResponseObject, error)
+
+	// (DELETE /v1/notificationTemplates/{id})
+	NotificationTemplatesV1Delete(ctx context.Context, request NotificationTemplatesV1DeleteRequestObject) (NotificationTemplatesV1DeleteResponseObject, error)
+
+	// (GET /v1/notificationTemplates/{id})
+	NotificationTemplatesV1Detail(ctx context.Context, request NotificationTemplatesV1DetailRequestObject) (NotificationTemplatesV1DetailResponseObject, error)
+
+	// (PUT /v1/notificationTemplates/{id})
+	NotificationTemplatesV1Update(ctx context.Context, request NotificationTemplatesV1UpdateRequestObject) (NotificationTemplatesV1UpdateResponseObject, error)
+
+	// (POST /v1/notificationTemplates/{id}/notifications)
+	NotificationTemplatesV1CreateNotification(ctx context.Context, request NotificationTemplatesV1CreateNotificationRequestObject) (NotificationTemplatesV1CreateNotificationResponseObject, error)
+
+	// (GET /v1/notifications)
+	NotificationV1List(ctx context.Context, request NotificationV1ListRequestObject) (NotificationV1ListResponseObject, error)
+
+	// (POST /v1/notifications)
+	NotificationV1Create(ctx context.Context, request NotificationV1CreateRequestObject) (NotificationV1CreateResponseObject, error)
+
+	// (POST /v1/notifications/audience)
+	NotificationV1CreateForAudience(ctx context.Context, request NotificationV1CreateForAudienceRequestObject) (NotificationV1CreateForAudienceResponseObject, error)
+
+	// (POST /v1/notifications/audience/preview)
+	NotificationV1PreviewAudience(ctx context.Context, request NotificationV1PreviewAudienceRequestObject) (NotificationV1PreviewAudienceResponseObject, error)
+
+	// (POST /v1/notifications/dispatch)
+	NotificationV1Dispatch(ctx context.Context, request NotificationV1DispatchRequestObject) (NotificationV1DispatchResponseObject, error)
+
+	// (GET /v1/notifications/lifecycle)
+	NotificationV1Lifecycle(ctx context.Context, request NotificationV1LifecycleRequestObject) (NotificationV1LifecycleResponseObject, error)
+
+	// (DELETE /v1/notifications/{id})
+	NotificationV1Delete(ctx context.Context, request NotificationV1DeleteRequestObject) (NotificationV1DeleteResponseObject, error)
+
+	// (GET /v1/notifications/{id})
+	NotificationV1Detail(ctx context.Context, request NotificationV1DetailRequestObject) (NotificationV1DetailResponseObject, error)
+
+	// (PUT /v1/notifications/{id})
+	NotificationV1Update(ctx context.Context, request NotificationV1UpdateRequestObject) (NotificationV1UpdateResponseObject, error)
+
+	// (GET /v1/personalCalendarItems)
+	PersonalCalendarItemsV1List(ctx context.Context, request PersonalCalendarItemsV1ListRequestObject) (PersonalCalendarItemsV1ListResponseObject, error)
+
+	// (GET /v1/reservations)
+	ReservationsV1List(ctx context.Context, request ReservationsV1ListRequestObject) (ReservationsV1ListResponseObject, error)
+
+	// (POST /v1/reservations)
+	ReservationsV1Create(ctx context.Context, request ReservationsV1CreateRequestObject) (ReservationsV1CreateResponseObject, error)
+
+	// (DELETE /v1/reservations/{id})
+	ReservationsV1Delete(ctx context.Context, request ReservationsV1DeleteRequestObject) (ReservationsV1DeleteResponseObject, error)
+
+	// (GET /v1/roomChanges)
+	RoomChangesV1List(ctx context.Context, request RoomChangesV1ListRequestObject) (RoomChangesV1ListResponseObject, error)
+
+	// (POST /v1/roomChanges)
+	RoomChangesV1Create(ctx context.Context, request RoomChangesV1CreateRequestObject) (RoomChangesV1CreateResponseObject, error)
+
+	// (DELETE /v1/roomChanges/{id})
+	RoomChangesV1Delete(ctx context.Context, request RoomChangesV1DeleteRequestObject) (RoomChangesV1DeleteResponseObject, error)
+
+	// (GET /v1/rooms)
+	RoomsV1List(ctx context.Context, request RoomsV1ListRequestObject) (RoomsV1ListResponseObject, error)
+
+	// (POST /v1/rooms)
+	RoomsV1Create(ctx context.Context, request RoomsV1CreateRequestObject) (RoomsV1CreateResponseObject, error)
+
+	// (DELETE /v1/rooms/{id})
+	RoomsV1Delete(ctx context.Context, request RoomsV1DeleteRequestObject) (RoomsV1DeleteResponseObject, error)
+
+	// (GET /v1/rooms/{id})
+	RoomsV1Detail(ctx context.Context, request RoomsV1DetailRequestObject) (RoomsV1DetailResponseObject, error)
+
+	// (PUT /v1/rooms/{id})
+	RoomsV1Update(ctx context.Context, request RoomsV1UpdateRequestObject) (RoomsV1UpdateResponseObject, error)
+
+	// (GET /v1/subjects)
+	SubjectsV1List(ctx context.Context, request SubjectsV1ListRequestObject) (SubjectsV1ListResponseObject, error)
+
+	// (DELETE /v1/subjects/{id})
+	SubjectsV1Delete(ctx context.Context, request SubjectsV1DeleteRequestObject) (SubjectsV1DeleteResponseObject, error)
+
+	// (GET /v1/subjects/{id})
+	SubjectsV1Detail(ctx context.Context, request SubjectsV1DetailRequestObject) (SubjectsV1DetailResponseObject, error)
+
+	// (GET /v1/subjects/{id}/syllabus)
+	SyllabusV1Detail(ctx context.Context, request SyllabusV1DetailRequestObject) (SyllabusV1DetailResponseObject, error)
+
+	// (GET /v1/timetableItmes)
+	TimetableItemsV1List(ctx context.Context, request TimetableItemsV1ListRequestObject) (TimetableItemsV1ListResponseObject, error)
+
+	// (POST /v1/timetableItmes)
+	TimetableItemsV1Create(ctx context.Context, request TimetableItemsV1CreateRequestObject) (TimetableItemsV1CreateResponseObject, error)
+
+	// (DELETE /v1/timetableItmes/{id})
+	TimetableItemsV1Delete(ctx context.Context, request TimetableItemsV1DeleteRequestObject) (TimetableItemsV1DeleteResponseObject, error)
+
+	// (GET /v1/users)
+	UsersV1List(ctx context.Context, request UsersV1ListRequestObject) (UsersV1ListResponseObject, error)
+
+	// (GET /v1/users/{id})
+	UsersV1Detail(ctx context.Context, request UsersV1DetailRequestObject) (UsersV1DetailResponseObject, error)
+
+	// (POST /v1/users/{id})
+	UsersV1Upsert(ctx context.Context, request UsersV1UpsertRequestObject) (UsersV1UpsertResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// AnnouncementsV1List operation middleware
+func (sh *strictHandler) AnnouncementsV1List(ctx *gin.Context, params AnnouncementsV1ListParams) {
+	var request AnnouncementsV1ListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AnnouncementsV1List(ctx, request.(AnnouncementsV1ListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AnnouncementsV1List")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AnnouncementsV1ListResponseObject); ok {
+		if err := validResponse.VisitAnnouncementsV1ListResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AnnouncementsV1Create operation middleware
+func (sh *strictHandler) AnnouncementsV1Create(ctx *gin.Context) {
+	var request AnnouncementsV1CreateRequestObject
+
+	var body AnnouncementsV1CreateJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AnnouncementsV1Create(ctx, request.(AnnouncementsV1CreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AnnouncementsV1Create")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AnnouncementsV1CreateResponseObject); ok {
+		if err := validResponse.VisitAnnouncementsV1CreateResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AnnouncementsV1Delete operation middleware
+func (sh *strictHandler) AnnouncementsV1Delete(ctx *gin.Context, id string) {
+	var request AnnouncementsV1DeleteRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AnnouncementsV1Delete(ctx, request.(AnnouncementsV1DeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AnnouncementsV1Delete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AnnouncementsV1DeleteResponseObject); ok {
+		if err := validResponse.VisitAnnouncementsV1DeleteResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AnnouncementsV1Detail operation middleware
+func (sh *strictHandler) AnnouncementsV1Detail(ctx *gin.Context, id string) {
+	var request AnnouncementsV1DetailRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AnnouncementsV1Detail(ctx, request.(AnnouncementsV1DetailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AnnouncementsV1Detail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AnnouncementsV1DetailResponseObject); ok {
+		if err := validResponse.VisitAnnouncementsV1DetailResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AnnouncementsV1Update operation middleware
+func (sh *strictHandler) AnnouncementsV1Update(ctx *gin.Context, id string, params AnnouncementsV1UpdateParams) {
+	var request AnnouncementsV1UpdateRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body AnnouncementsV1UpdateJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AnnouncementsV1Update(ctx, request.(AnnouncementsV1UpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AnnouncementsV1Update")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AnnouncementsV1UpdateResponseObject); ok {
+		if err := validResponse.VisitAnnouncementsV1UpdateResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AuditLogsV1List operation middleware
+func (sh *strictHandler) AuditLogsV1List(ctx *gin.Context, params AuditLogsV1ListParams) {
+	var request AuditLogsV1ListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AuditLogsV1List(ctx, request.(AuditLogsV1ListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuditLogsV1List")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AuditLogsV1ListResponseObject); ok {
+		if err := validResponse.VisitAuditLogsV1ListResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CacheV1Flush operation middleware
+func (sh *strictHandler) CacheV1Flush(ctx *gin.Context) {
+	var request CacheV1FlushRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CacheV1Flush(ctx, request.(CacheV1FlushRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CacheV1Flush")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CacheV1FlushResponseObject); ok {
+		if err := validResponse.VisitCacheV1FlushResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CancelledClassesV1List operation middleware
+func (sh *strictHandler) CancelledClassesV1List(ctx *gin.Context, params CancelledClassesV1ListParams) {
+	var request CancelledClassesV1ListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelledClassesV1List(ctx, request.(CancelledClassesV1ListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelledClassesV1List")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CancelledClassesV1ListResponseObject); ok {
+		if err := validResponse.VisitCancelledClassesV1ListResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CancelledClassesV1Create operation middleware
+func (sh *strictHandler) CancelledClassesV1Create(ctx *gin.Context, params CancelledClassesV1CreateParams) {
+	var request CancelledClassesV1CreateRequestObject
+
+	request.Params = params
+
+	var body CancelledClassesV1CreateJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelledClassesV1Create(ctx, request.(CancelledClassesV1CreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelledClassesV1Create")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CancelledClassesV1CreateResponseObject); ok {
+		if err := validResponse.VisitCancelledClassesV1CreateResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CancelledClassesV1Delete operation middleware
+func (sh *strictHandler) CancelledClassesV1Delete(ctx *gin.Context, id string) {
+	var request CancelledClassesV1DeleteRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelledClassesV1Delete(ctx, request.(CancelledClassesV1DeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelledClassesV1Delete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CancelledClassesV1DeleteResponseObject); ok {
+		if err := validResponse.VisitCancelledClassesV1DeleteResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-}
+// CourseRegistrationsV1List operation middleware
+func (sh *strictHandler) CourseRegistrationsV1List(ctx *gin.Context, params CourseRegistrationsV1ListParams) {
+	var request CourseRegistrationsV1ListRequestObject
 
-// AnnouncementsV1List operation middleware
-func (sh *strictHandler) AnnouncementsV1List(ctx *gin.Context) {
-	var request AnnouncementsV1ListRequestObject
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AnnouncementsV1List(ctx, request.(AnnouncementsV1ListRequestObject))
+		return sh.ssi.CourseRegistrationsV1List(ctx, request.(CourseRegistrationsV1ListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AnnouncementsV1List")
+		handler = middleware(handler, "CourseRegistrationsV1List")
 	}
 
 	response, err := handler(ctx, request)
@@ -4280,8 +9054,8 @@ func (sh *strictHandler) AnnouncementsV1List(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(AnnouncementsV1ListResponseObject); ok {
-		if err := validResponse.VisitAnnouncementsV1ListResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CourseRegistrationsV1ListResponseObject); ok {
+		if err := validResponse.VisitCourseRegistrationsV1ListResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4289,11 +9063,11 @@ func (sh *strictHandler) AnnouncementsV1List(ctx *gin.Context) {
 	}
 }
 
-// AnnouncementsV1Create operation middleware
-func (sh *strictHandler) AnnouncementsV1Create(ctx *gin.Context) {
-	var request AnnouncementsV1CreateRequestObject
+// CourseRegistrationsV1Create operation middleware
+func (sh *strictHandler) CourseRegistrationsV1Create(ctx *gin.Context) {
+	var request CourseRegistrationsV1CreateRequestObject
 
-	var body AnnouncementsV1CreateJSONRequestBody
+	var body CourseRegistrationsV1CreateJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -4302,10 +9076,10 @@ func (sh *strictHandler) AnnouncementsV1Create(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AnnouncementsV1Create(ctx, request.(AnnouncementsV1CreateRequestObject))
+		return sh.ssi.CourseRegistrationsV1Create(ctx, request.(CourseRegistrationsV1CreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AnnouncementsV1Create")
+		handler = middleware(handler, "CourseRegistrationsV1Create")
 	}
 
 	response, err := handler(ctx, request)
@@ -4313,8 +9087,8 @@ func (sh *strictHandler) AnnouncementsV1Create(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(AnnouncementsV1CreateResponseObject); ok {
-		if err := validResponse.VisitAnnouncementsV1CreateResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CourseRegistrationsV1CreateResponseObject); ok {
+		if err := validResponse.VisitCourseRegistrationsV1CreateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4322,17 +9096,17 @@ func (sh *strictHandler) AnnouncementsV1Create(ctx *gin.Context) {
 	}
 }
 
-// AnnouncementsV1Delete operation middleware
-func (sh *strictHandler) AnnouncementsV1Delete(ctx *gin.Context, id string) {
-	var request AnnouncementsV1DeleteRequestObject
+// CourseRegistrationsV1Delete operation middleware
+func (sh *strictHandler) CourseRegistrationsV1Delete(ctx *gin.Context, id string) {
+	var request CourseRegistrationsV1DeleteRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AnnouncementsV1Delete(ctx, request.(AnnouncementsV1DeleteRequestObject))
+		return sh.ssi.CourseRegistrationsV1Delete(ctx, request.(CourseRegistrationsV1DeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AnnouncementsV1Delete")
+		handler = middleware(handler, "CourseRegistrationsV1Delete")
 	}
 
 	response, err := handler(ctx, request)
@@ -4340,8 +9114,8 @@ func (sh *strictHandler) AnnouncementsV1Delete(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(AnnouncementsV1DeleteResponseObject); ok {
-		if err := validResponse.VisitAnnouncementsV1DeleteResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CourseRegistrationsV1DeleteResponseObject); ok {
+		if err := validResponse.VisitCourseRegistrationsV1DeleteResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4349,17 +9123,17 @@ func (sh *strictHandler) AnnouncementsV1Delete(ctx *gin.Context, id string) {
 	}
 }
 
-// AnnouncementsV1Detail operation middleware
-func (sh *strictHandler) AnnouncementsV1Detail(ctx *gin.Context, id string) {
-	var request AnnouncementsV1DetailRequestObject
+// FacultiesV1List operation middleware
+func (sh *strictHandler) FacultiesV1List(ctx *gin.Context, params FacultiesV1ListParams) {
+	var request FacultiesV1ListRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AnnouncementsV1Detail(ctx, request.(AnnouncementsV1DetailRequestObject))
+		return sh.ssi.FacultiesV1List(ctx, request.(FacultiesV1ListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AnnouncementsV1Detail")
+		handler = middleware(handler, "FacultiesV1List")
 	}
 
 	response, err := handler(ctx, request)
@@ -4367,8 +9141,8 @@ func (sh *strictHandler) AnnouncementsV1Detail(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(AnnouncementsV1DetailResponseObject); ok {
-		if err := validResponse.VisitAnnouncementsV1DetailResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(FacultiesV1ListResponseObject); ok {
+		if err := validResponse.VisitFacultiesV1ListResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4376,13 +9150,11 @@ func (sh *strictHandler) AnnouncementsV1Detail(ctx *gin.Context, id string) {
 	}
 }
 
-// AnnouncementsV1Update operation middleware
-func (sh *strictHandler) AnnouncementsV1Update(ctx *gin.Context, id string) {
-	var request AnnouncementsV1UpdateRequestObject
-
-	request.Id = id
+// FacultiesV1Create operation middleware
+func (sh *strictHandler) FacultiesV1Create(ctx *gin.Context) {
+	var request FacultiesV1CreateRequestObject
 
-	var body AnnouncementsV1UpdateJSONRequestBody
+	var body FacultiesV1CreateJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -4391,10 +9163,10 @@ func (sh *strictHandler) AnnouncementsV1Update(ctx *gin.Context, id string) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AnnouncementsV1Update(ctx, request.(AnnouncementsV1UpdateRequestObject))
+		return sh.ssi.FacultiesV1Create(ctx, request.(FacultiesV1CreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AnnouncementsV1Update")
+		handler = middleware(handler, "FacultiesV1Create")
 	}
 
 	response, err := handler(ctx, request)
@@ -4402,8 +9174,8 @@ func (sh *strictHandler) AnnouncementsV1Update(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(AnnouncementsV1UpdateResponseObject); ok {
-		if err := validResponse.VisitAnnouncementsV1UpdateResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(FacultiesV1CreateResponseObject); ok {
+		if err := validResponse.VisitFacultiesV1CreateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4411,17 +9183,17 @@ func (sh *strictHandler) AnnouncementsV1Update(ctx *gin.Context, id string) {
 	}
 }
 
-// CancelledClassesV1List operation middleware
-func (sh *strictHandler) CancelledClassesV1List(ctx *gin.Context, params CancelledClassesV1ListParams) {
-	var request CancelledClassesV1ListRequestObject
+// FacultiesV1Delete operation middleware
+func (sh *strictHandler) FacultiesV1Delete(ctx *gin.Context, id string) {
+	var request FacultiesV1DeleteRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CancelledClassesV1List(ctx, request.(CancelledClassesV1ListRequestObject))
+		return sh.ssi.FacultiesV1Delete(ctx, request.(FacultiesV1DeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CancelledClassesV1List")
+		handler = middleware(handler, "FacultiesV1Delete")
 	}
 
 	response, err := handler(ctx, request)
@@ -4429,8 +9201,8 @@ func (sh *strictHandler) CancelledClassesV1List(ctx *gin.Context, params Cancell
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CancelledClassesV1ListResponseObject); ok {
-		if err := validResponse.VisitCancelledClassesV1ListResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(FacultiesV1DeleteResponseObject); ok {
+		if err := validResponse.VisitFacultiesV1DeleteResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4438,23 +9210,17 @@ func (sh *strictHandler) CancelledClassesV1List(ctx *gin.Context, params Cancell
 	}
 }
 
-// CancelledClassesV1Create operation middleware
-func (sh *strictHandler) CancelledClassesV1Create(ctx *gin.Context) {
-	var request CancelledClassesV1CreateRequestObject
+// FacultiesV1Detail operation middleware
+func (sh *strictHandler) FacultiesV1Detail(ctx *gin.Context, id string) {
+	var request FacultiesV1DetailRequestObject
 
-	var body CancelledClassesV1CreateJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CancelledClassesV1Create(ctx, request.(CancelledClassesV1CreateRequestObject))
+		return sh.ssi.FacultiesV1Detail(ctx, request.(FacultiesV1DetailRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CancelledClassesV1Create")
+		handler = middleware(handler, "FacultiesV1Detail")
 	}
 
 	response, err := handler(ctx, request)
@@ -4462,8 +9228,8 @@ func (sh *strictHandler) CancelledClassesV1Create(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CancelledClassesV1CreateResponseObject); ok {
-		if err := validResponse.VisitCancelledClassesV1CreateResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(FacultiesV1DetailResponseObject); ok {
+		if err := validResponse.VisitFacultiesV1DetailResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4471,17 +9237,26 @@ func (sh *strictHandler) CancelledClassesV1Create(ctx *gin.Context) {
 	}
 }
 
-// CancelledClassesV1Delete operation middleware
-func (sh *strictHandler) CancelledClassesV1Delete(ctx *gin.Context, id string) {
-	var request CancelledClassesV1DeleteRequestObject
+// FacultiesV1Update operation middleware
+func (sh *strictHandler) FacultiesV1Update(ctx *gin.Context, id string, params FacultiesV1UpdateParams) {
+	var request FacultiesV1UpdateRequestObject
 
 	request.Id = id
+	request.Params = params
+
+	var body FacultiesV1UpdateJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CancelledClassesV1Delete(ctx, request.(CancelledClassesV1DeleteRequestObject))
+		return sh.ssi.FacultiesV1Update(ctx, request.(FacultiesV1UpdateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CancelledClassesV1Delete")
+		handler = middleware(handler, "FacultiesV1Update")
 	}
 
 	response, err := handler(ctx, request)
@@ -4489,8 +9264,8 @@ func (sh *strictHandler) CancelledClassesV1Delete(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CancelledClassesV1DeleteResponseObject); ok {
-		if err := validResponse.VisitCancelledClassesV1DeleteResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(FacultiesV1UpdateResponseObject); ok {
+		if err := validResponse.VisitFacultiesV1UpdateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4498,17 +9273,17 @@ func (sh *strictHandler) CancelledClassesV1Delete(ctx *gin.Context, id string) {
 	}
 }
 
-// CourseRegistrationsV1List operation middleware
-func (sh *strictHandler) CourseRegistrationsV1List(ctx *gin.Context, params CourseRegistrationsV1ListParams) {
-	var request CourseRegistrationsV1ListRequestObject
+// FacultyRoomsV1List operation middleware
+func (sh *strictHandler) FacultyRoomsV1List(ctx *gin.Context, params FacultyRoomsV1ListParams) {
+	var request FacultyRoomsV1ListRequestObject
 
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CourseRegistrationsV1List(ctx, request.(CourseRegistrationsV1ListRequestObject))
+		return sh.ssi.FacultyRoomsV1List(ctx, request.(FacultyRoomsV1ListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CourseRegistrationsV1List")
+		handler = middleware(handler, "FacultyRoomsV1List")
 	}
 
 	response, err := handler(ctx, request)
@@ -4516,8 +9291,8 @@ func (sh *strictHandler) CourseRegistrationsV1List(ctx *gin.Context, params Cour
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CourseRegistrationsV1ListResponseObject); ok {
-		if err := validResponse.VisitCourseRegistrationsV1ListResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(FacultyRoomsV1ListResponseObject); ok {
+		if err := validResponse.VisitFacultyRoomsV1ListResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4525,11 +9300,11 @@ func (sh *strictHandler) CourseRegistrationsV1List(ctx *gin.Context, params Cour
 	}
 }
 
-// CourseRegistrationsV1Create operation middleware
-func (sh *strictHandler) CourseRegistrationsV1Create(ctx *gin.Context) {
-	var request CourseRegistrationsV1CreateRequestObject
+// FacultyRoomsV1Create operation middleware
+func (sh *strictHandler) FacultyRoomsV1Create(ctx *gin.Context) {
+	var request FacultyRoomsV1CreateRequestObject
 
-	var body CourseRegistrationsV1CreateJSONRequestBody
+	var body FacultyRoomsV1CreateJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -4538,10 +9313,10 @@ func (sh *strictHandler) CourseRegistrationsV1Create(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CourseRegistrationsV1Create(ctx, request.(CourseRegistrationsV1CreateRequestObject))
+		return sh.ssi.FacultyRoomsV1Create(ctx, request.(FacultyRoomsV1CreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CourseRegistrationsV1Create")
+		handler = middleware(handler, "FacultyRoomsV1Create")
 	}
 
 	response, err := handler(ctx, request)
@@ -4549,8 +9324,8 @@ func (sh *strictHandler) CourseRegistrationsV1Create(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CourseRegistrationsV1CreateResponseObject); ok {
-		if err := validResponse.VisitCourseRegistrationsV1CreateResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(FacultyRoomsV1CreateResponseObject); ok {
+		if err := validResponse.VisitFacultyRoomsV1CreateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4558,17 +9333,17 @@ func (sh *strictHandler) CourseRegistrationsV1Create(ctx *gin.Context) {
 	}
 }
 
-// CourseRegistrationsV1Delete operation middleware
-func (sh *strictHandler) CourseRegistrationsV1Delete(ctx *gin.Context, id string) {
-	var request CourseRegistrationsV1DeleteRequestObject
+// FacultyRoomsV1Delete operation middleware
+func (sh *strictHandler) FacultyRoomsV1Delete(ctx *gin.Context, id string) {
+	var request FacultyRoomsV1DeleteRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CourseRegistrationsV1Delete(ctx, request.(CourseRegistrationsV1DeleteRequestObject))
+		return sh.ssi.FacultyRoomsV1Delete(ctx, request.(FacultyRoomsV1DeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CourseRegistrationsV1Delete")
+		handler = middleware(handler, "FacultyRoomsV1Delete")
 	}
 
 	response, err := handler(ctx, request)
@@ -4576,8 +9351,8 @@ func (sh *strictHandler) CourseRegistrationsV1Delete(ctx *gin.Context, id string
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CourseRegistrationsV1DeleteResponseObject); ok {
-		if err := validResponse.VisitCourseRegistrationsV1DeleteResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(FacultyRoomsV1DeleteResponseObject); ok {
+		if err := validResponse.VisitFacultyRoomsV1DeleteResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4585,17 +9360,17 @@ func (sh *strictHandler) CourseRegistrationsV1Delete(ctx *gin.Context, id string
 	}
 }
 
-// FacultiesV1List operation middleware
-func (sh *strictHandler) FacultiesV1List(ctx *gin.Context, params FacultiesV1ListParams) {
-	var request FacultiesV1ListRequestObject
+// FCMTokenV1List operation middleware
+func (sh *strictHandler) FCMTokenV1List(ctx *gin.Context, params FCMTokenV1ListParams) {
+	var request FCMTokenV1ListRequestObject
 
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.FacultiesV1List(ctx, request.(FacultiesV1ListRequestObject))
+		return sh.ssi.FCMTokenV1List(ctx, request.(FCMTokenV1ListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FacultiesV1List")
+		handler = middleware(handler, "FCMTokenV1List")
 	}
 
 	response, err := handler(ctx, request)
@@ -4603,8 +9378,8 @@ func (sh *strictHandler) FacultiesV1List(ctx *gin.Context, params FacultiesV1Lis
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(FacultiesV1ListResponseObject); ok {
-		if err := validResponse.VisitFacultiesV1ListResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(FCMTokenV1ListResponseObject); ok {
+		if err := validResponse.VisitFCMTokenV1ListResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4612,11 +9387,11 @@ func (sh *strictHandler) FacultiesV1List(ctx *gin.Context, params FacultiesV1Lis
 	}
 }
 
-// FacultiesV1Create operation middleware
-func (sh *strictHandler) FacultiesV1Create(ctx *gin.Context) {
-	var request FacultiesV1CreateRequestObject
+// FCMTokenV1Upsert operation middleware
+func (sh *strictHandler) FCMTokenV1Upsert(ctx *gin.Context) {
+	var request FCMTokenV1UpsertRequestObject
 
-	var body FacultiesV1CreateJSONRequestBody
+	var body FCMTokenV1UpsertJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -4625,10 +9400,10 @@ func (sh *strictHandler) FacultiesV1Create(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.FacultiesV1Create(ctx, request.(FacultiesV1CreateRequestObject))
+		return sh.ssi.FCMTokenV1Upsert(ctx, request.(FCMTokenV1UpsertRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FacultiesV1Create")
+		handler = middleware(handler, "FCMTokenV1Upsert")
 	}
 
 	response, err := handler(ctx, request)
@@ -4636,8 +9411,8 @@ func (sh *strictHandler) FacultiesV1Create(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(FacultiesV1CreateResponseObject); ok {
-		if err := validResponse.VisitFacultiesV1CreateResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(FCMTokenV1UpsertResponseObject); ok {
+		if err := validResponse.VisitFCMTokenV1UpsertResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4645,17 +9420,52 @@ func (sh *strictHandler) FacultiesV1Create(ctx *gin.Context) {
 	}
 }
 
-// FacultiesV1Delete operation middleware
-func (sh *strictHandler) FacultiesV1Delete(ctx *gin.Context, id string) {
-	var request FacultiesV1DeleteRequestObject
+// MakeupClassesV1List operation middleware
+func (sh *strictHandler) MakeupClassesV1List(ctx *gin.Context, params MakeupClassesV1ListParams) {
+	var request MakeupClassesV1ListRequestObject
 
-	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MakeupClassesV1List(ctx, request.(MakeupClassesV1ListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MakeupClassesV1List")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(MakeupClassesV1ListResponseObject); ok {
+		if err := validResponse.VisitMakeupClassesV1ListResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MakeupClassesV1Create operation middleware
+func (sh *strictHandler) MakeupClassesV1Create(ctx *gin.Context, params MakeupClassesV1CreateParams) {
+	var request MakeupClassesV1CreateRequestObject
+
+	request.Params = params
+
+	var body MakeupClassesV1CreateJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.FacultiesV1Delete(ctx, request.(FacultiesV1DeleteRequestObject))
+		return sh.ssi.MakeupClassesV1Create(ctx, request.(MakeupClassesV1CreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FacultiesV1Delete")
+		handler = middleware(handler, "MakeupClassesV1Create")
 	}
 
 	response, err := handler(ctx, request)
@@ -4663,8 +9473,8 @@ func (sh *strictHandler) FacultiesV1Delete(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(FacultiesV1DeleteResponseObject); ok {
-		if err := validResponse.VisitFacultiesV1DeleteResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(MakeupClassesV1CreateResponseObject); ok {
+		if err := validResponse.VisitMakeupClassesV1CreateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4672,17 +9482,17 @@ func (sh *strictHandler) FacultiesV1Delete(ctx *gin.Context, id string) {
 	}
 }
 
-// FacultiesV1Detail operation middleware
-func (sh *strictHandler) FacultiesV1Detail(ctx *gin.Context, id string) {
-	var request FacultiesV1DetailRequestObject
+// MakeupClassesV1Delete operation middleware
+func (sh *strictHandler) MakeupClassesV1Delete(ctx *gin.Context, id string) {
+	var request MakeupClassesV1DeleteRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.FacultiesV1Detail(ctx, request.(FacultiesV1DetailRequestObject))
+		return sh.ssi.MakeupClassesV1Delete(ctx, request.(MakeupClassesV1DeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FacultiesV1Detail")
+		handler = middleware(handler, "MakeupClassesV1Delete")
 	}
 
 	response, err := handler(ctx, request)
@@ -4690,8 +9500,8 @@ func (sh *strictHandler) FacultiesV1Detail(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(FacultiesV1DetailResponseObject); ok {
-		if err := validResponse.VisitFacultiesV1DetailResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(MakeupClassesV1DeleteResponseObject); ok {
+		if err := validResponse.VisitMakeupClassesV1DeleteResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4699,25 +9509,17 @@ func (sh *strictHandler) FacultiesV1Detail(ctx *gin.Context, id string) {
 	}
 }
 
-// FacultiesV1Update operation middleware
-func (sh *strictHandler) FacultiesV1Update(ctx *gin.Context, id string) {
-	var request FacultiesV1UpdateRequestObject
-
-	request.Id = id
+// MenuItemsV1List operation middleware
+func (sh *strictHandler) MenuItemsV1List(ctx *gin.Context, params MenuItemsV1ListParams) {
+	var request MenuItemsV1ListRequestObject
 
-	var body FacultiesV1UpdateJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.FacultiesV1Update(ctx, request.(FacultiesV1UpdateRequestObject))
+		return sh.ssi.MenuItemsV1List(ctx, request.(MenuItemsV1ListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FacultiesV1Update")
+		handler = middleware(handler, "MenuItemsV1List")
 	}
 
 	response, err := handler(ctx, request)
@@ -4725,8 +9527,8 @@ func (sh *strictHandler) FacultiesV1Update(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(FacultiesV1UpdateResponseObject); ok {
-		if err := validResponse.VisitFacultiesV1UpdateResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(MenuItemsV1ListResponseObject); ok {
+		if err := validResponse.VisitMenuItemsV1ListResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4734,17 +9536,15 @@ func (sh *strictHandler) FacultiesV1Update(ctx *gin.Context, id string) {
 	}
 }
 
-// FacultyRoomsV1List operation middleware
-func (sh *strictHandler) FacultyRoomsV1List(ctx *gin.Context, params FacultyRoomsV1ListParams) {
-	var request FacultyRoomsV1ListRequestObject
-
-	request.Params = params
+// NotificationTemplatesV1List operation middleware
+func (sh *strictHandler) NotificationTemplatesV1List(ctx *gin.Context) {
+	var request NotificationTemplatesV1ListRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.FacultyRoomsV1List(ctx, request.(FacultyRoomsV1ListRequestObject))
+		return sh.ssi.NotificationTemplatesV1List(ctx, request.(NotificationTemplatesV1ListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FacultyRoomsV1List")
+		handler = middleware(handler, "NotificationTemplatesV1List")
 	}
 
 	response, err := handler(ctx, request)
@@ -4752,8 +9552,8 @@ func (sh *strictHandler) FacultyRoomsV1List(ctx *gin.Context, params FacultyRoom
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(FacultyRoomsV1ListResponseObject); ok {
-		if err := validResponse.VisitFacultyRoomsV1ListResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(NotificationTemplatesV1ListResponseObject); ok {
+		if err := validResponse.VisitNotificationTemplatesV1ListResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4761,11 +9561,11 @@ func (sh *strictHandler) FacultyRoomsV1List(ctx *gin.Context, params FacultyRoom
 	}
 }
 
-// FacultyRoomsV1Create operation middleware
-func (sh *strictHandler) FacultyRoomsV1Create(ctx *gin.Context) {
-	var request FacultyRoomsV1CreateRequestObject
+// NotificationTemplatesV1Create operation middleware
+func (sh *strictHandler) NotificationTemplatesV1Create(ctx *gin.Context) {
+	var request NotificationTemplatesV1CreateRequestObject
 
-	var body FacultyRoomsV1CreateJSONRequestBody
+	var body NotificationTemplatesV1CreateJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -4774,10 +9574,10 @@ func (sh *strictHandler) FacultyRoomsV1Create(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.FacultyRoomsV1Create(ctx, request.(FacultyRoomsV1CreateRequestObject))
+		return sh.ssi.NotificationTemplatesV1Create(ctx, request.(NotificationTemplatesV1CreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FacultyRoomsV1Create")
+		handler = middleware(handler, "NotificationTemplatesV1Create")
 	}
 
 	response, err := handler(ctx, request)
@@ -4785,8 +9585,8 @@ func (sh *strictHandler) FacultyRoomsV1Create(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(FacultyRoomsV1CreateResponseObject); ok {
-		if err := validResponse.VisitFacultyRoomsV1CreateResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(NotificationTemplatesV1CreateResponseObject); ok {
+		if err := validResponse.VisitNotificationTemplatesV1CreateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4794,17 +9594,17 @@ func (sh *strictHandler) FacultyRoomsV1Create(ctx *gin.Context) {
 	}
 }
 
-// FacultyRoomsV1Delete operation middleware
-func (sh *strictHandler) FacultyRoomsV1Delete(ctx *gin.Context, id string) {
-	var request FacultyRoomsV1DeleteRequestObject
+// NotificationTemplatesV1Delete operation middleware
+func (sh *strictHandler) NotificationTemplatesV1Delete(ctx *gin.Context, id string) {
+	var request NotificationTemplatesV1DeleteRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.FacultyRoomsV1Delete(ctx, request.(FacultyRoomsV1DeleteRequestObject))
+		return sh.ssi.NotificationTemplatesV1Delete(ctx, request.(NotificationTemplatesV1DeleteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FacultyRoomsV1Delete")
+		handler = middleware(handler, "NotificationTemplatesV1Delete")
 	}
 
 	response, err := handler(ctx, request)
@@ -4812,8 +9612,8 @@ func (sh *strictHandler) FacultyRoomsV1Delete(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(FacultyRoomsV1DeleteResponseObject); ok {
-		if err := validResponse.VisitFacultyRoomsV1DeleteResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(NotificationTemplatesV1DeleteResponseObject); ok {
+		if err := validResponse.VisitNotificationTemplatesV1DeleteResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4821,17 +9621,17 @@ func (sh *strictHandler) FacultyRoomsV1Delete(ctx *gin.Context, id string) {
 	}
 }
 
-// FCMTokenV1List operation middleware
-func (sh *strictHandler) FCMTokenV1List(ctx *gin.Context, params FCMTokenV1ListParams) {
-	var request FCMTokenV1ListRequestObject
+// NotificationTemplatesV1Detail operation middleware
+func (sh *strictHandler) NotificationTemplatesV1Detail(ctx *gin.Context, id string) {
+	var request NotificationTemplatesV1DetailRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.FCMTokenV1List(ctx, request.(FCMTokenV1ListRequestObject))
+		return sh.ssi.NotificationTemplatesV1Detail(ctx, request.(NotificationTemplatesV1DetailRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FCMTokenV1List")
+		handler = middleware(handler, "NotificationTemplatesV1Detail")
 	}
 
 	response, err := handler(ctx, request)
@@ -4839,8 +9639,8 @@ func (sh *strictHandler) FCMTokenV1List(ctx *gin.Context, params FCMTokenV1ListP
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(FCMTokenV1ListResponseObject); ok {
-		if err := validResponse.VisitFCMTokenV1ListResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(NotificationTemplatesV1DetailResponseObject); ok {
+		if err := validResponse.VisitNotificationTemplatesV1DetailResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4848,11 +9648,13 @@ func (sh *strictHandler) FCMTokenV1List(ctx *gin.Context, params FCMTokenV1ListP
 	}
 }
 
-// FCMTokenV1Upsert operation middleware
-func (sh *strictHandler) FCMTokenV1Upsert(ctx *gin.Context) {
-	var request FCMTokenV1UpsertRequestObject
+// NotificationTemplatesV1Update operation middleware
+func (sh *strictHandler) NotificationTemplatesV1Update(ctx *gin.Context, id string) {
+	var request NotificationTemplatesV1UpdateRequestObject
 
-	var body FCMTokenV1UpsertJSONRequestBody
+	request.Id = id
+
+	var body NotificationTemplatesV1UpdateJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -4861,10 +9663,10 @@ func (sh *strictHandler) FCMTokenV1Upsert(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.FCMTokenV1Upsert(ctx, request.(FCMTokenV1UpsertRequestObject))
+		return sh.ssi.NotificationTemplatesV1Update(ctx, request.(NotificationTemplatesV1UpdateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FCMTokenV1Upsert")
+		handler = middleware(handler, "NotificationTemplatesV1Update")
 	}
 
 	response, err := handler(ctx, request)
@@ -4872,8 +9674,8 @@ func (sh *strictHandler) FCMTokenV1Upsert(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(FCMTokenV1UpsertResponseObject); ok {
-		if err := validResponse.VisitFCMTokenV1UpsertResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(NotificationTemplatesV1UpdateResponseObject); ok {
+		if err := validResponse.VisitNotificationTemplatesV1UpdateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4881,38 +9683,13 @@ func (sh *strictHandler) FCMTokenV1Upsert(ctx *gin.Context) {
 	}
 }
 
-// MakeupClassesV1List operation middleware
-func (sh *strictHandler) MakeupClassesV1List(ctx *gin.Context, params MakeupClassesV1ListParams) {
-	var request MakeupClassesV1ListRequestObject
-
-	request.Params = params
-
-	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.MakeupClassesV1List(ctx, request.(MakeupClassesV1ListRequestObject))
-	}
-	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MakeupClassesV1List")
-	}
-
-	response, err := handler(ctx, request)
-
-	if err != nil {
-		ctx.Error(err)
-		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(MakeupClassesV1ListResponseObject); ok {
-		if err := validResponse.VisitMakeupClassesV1ListResponse(ctx.Writer); err != nil {
-			ctx.Error(err)
-		}
-	} else if response != nil {
-		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
-	}
-}
+// NotificationTemplatesV1CreateNotification operation middleware
+func (sh *strictHandler) NotificationTemplatesV1CreateNotification(ctx *gin.Context, id string) {
+	var request NotificationTemplatesV1CreateNotificationRequestObject
 
-// MakeupClassesV1Create operation middleware
-func (sh *strictHandler) MakeupClassesV1Create(ctx *gin.Context) {
-	var request MakeupClassesV1CreateRequestObject
+	request.Id = id
 
-	var body MakeupClassesV1CreateJSONRequestBody
+	var body NotificationTemplatesV1CreateNotificationJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -4921,10 +9698,10 @@ func (sh *strictHandler) MakeupClassesV1Create(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.MakeupClassesV1Create(ctx, request.(MakeupClassesV1CreateRequestObject))
+		return sh.ssi.NotificationTemplatesV1CreateNotification(ctx, request.(NotificationTemplatesV1CreateNotificationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MakeupClassesV1Create")
+		handler = middleware(handler, "NotificationTemplatesV1CreateNotification")
 	}
 
 	response, err := handler(ctx, request)
@@ -4932,8 +9709,8 @@ func (sh *strictHandler) MakeupClassesV1Create(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(MakeupClassesV1CreateResponseObject); ok {
-		if err := validResponse.VisitMakeupClassesV1CreateResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(NotificationTemplatesV1CreateNotificationResponseObject); ok {
+		if err := validResponse.VisitNotificationTemplatesV1CreateNotificationResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4941,17 +9718,17 @@ func (sh *strictHandler) MakeupClassesV1Create(ctx *gin.Context) {
 	}
 }
 
-// MakeupClassesV1Delete operation middleware
-func (sh *strictHandler) MakeupClassesV1Delete(ctx *gin.Context, id string) {
-	var request MakeupClassesV1DeleteRequestObject
+// NotificationV1List operation middleware
+func (sh *strictHandler) NotificationV1List(ctx *gin.Context, params NotificationV1ListParams) {
+	var request NotificationV1ListRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.MakeupClassesV1Delete(ctx, request.(MakeupClassesV1DeleteRequestObject))
+		return sh.ssi.NotificationV1List(ctx, request.(NotificationV1ListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MakeupClassesV1Delete")
+		handler = middleware(handler, "NotificationV1List")
 	}
 
 	response, err := handler(ctx, request)
@@ -4959,8 +9736,8 @@ func (sh *strictHandler) MakeupClassesV1Delete(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(MakeupClassesV1DeleteResponseObject); ok {
-		if err := validResponse.VisitMakeupClassesV1DeleteResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(NotificationV1ListResponseObject); ok {
+		if err := validResponse.VisitNotificationV1ListResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4968,17 +9745,23 @@ func (sh *strictHandler) MakeupClassesV1Delete(ctx *gin.Context, id string) {
 	}
 }
 
-// MenuItemsV1List operation middleware
-func (sh *strictHandler) MenuItemsV1List(ctx *gin.Context, params MenuItemsV1ListParams) {
-	var request MenuItemsV1ListRequestObject
+// NotificationV1Create operation middleware
+func (sh *strictHandler) NotificationV1Create(ctx *gin.Context) {
+	var request NotificationV1CreateRequestObject
 
-	request.Params = params
+	var body NotificationV1CreateJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.MenuItemsV1List(ctx, request.(MenuItemsV1ListRequestObject))
+		return sh.ssi.NotificationV1Create(ctx, request.(NotificationV1CreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MenuItemsV1List")
+		handler = middleware(handler, "NotificationV1Create")
 	}
 
 	response, err := handler(ctx, request)
@@ -4986,8 +9769,8 @@ func (sh *strictHandler) MenuItemsV1List(ctx *gin.Context, params MenuItemsV1Lis
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(MenuItemsV1ListResponseObject); ok {
-		if err := validResponse.VisitMenuItemsV1ListResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(NotificationV1CreateResponseObject); ok {
+		if err := validResponse.VisitNotificationV1CreateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -4995,17 +9778,23 @@ func (sh *strictHandler) MenuItemsV1List(ctx *gin.Context, params MenuItemsV1Lis
 	}
 }
 
-// NotificationV1List operation middleware
-func (sh *strictHandler) NotificationV1List(ctx *gin.Context, params NotificationV1ListParams) {
-	var request NotificationV1ListRequestObject
+// NotificationV1CreateForAudience operation middleware
+func (sh *strictHandler) NotificationV1CreateForAudience(ctx *gin.Context) {
+	var request NotificationV1CreateForAudienceRequestObject
 
-	request.Params = params
+	var body NotificationV1CreateForAudienceJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.NotificationV1List(ctx, request.(NotificationV1ListRequestObject))
+		return sh.ssi.NotificationV1CreateForAudience(ctx, request.(NotificationV1CreateForAudienceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "NotificationV1List")
+		handler = middleware(handler, "NotificationV1CreateForAudience")
 	}
 
 	response, err := handler(ctx, request)
@@ -5013,8 +9802,8 @@ func (sh *strictHandler) NotificationV1List(ctx *gin.Context, params Notificatio
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(NotificationV1ListResponseObject); ok {
-		if err := validResponse.VisitNotificationV1ListResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(NotificationV1CreateForAudienceResponseObject); ok {
+		if err := validResponse.VisitNotificationV1CreateForAudienceResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -5022,11 +9811,11 @@ func (sh *strictHandler) NotificationV1List(ctx *gin.Context, params Notificatio
 	}
 }
 
-// NotificationV1Create operation middleware
-func (sh *strictHandler) NotificationV1Create(ctx *gin.Context) {
-	var request NotificationV1CreateRequestObject
+// NotificationV1PreviewAudience operation middleware
+func (sh *strictHandler) NotificationV1PreviewAudience(ctx *gin.Context) {
+	var request NotificationV1PreviewAudienceRequestObject
 
-	var body NotificationV1CreateJSONRequestBody
+	var body NotificationV1PreviewAudienceJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -5035,10 +9824,10 @@ func (sh *strictHandler) NotificationV1Create(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.NotificationV1Create(ctx, request.(NotificationV1CreateRequestObject))
+		return sh.ssi.NotificationV1PreviewAudience(ctx, request.(NotificationV1PreviewAudienceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "NotificationV1Create")
+		handler = middleware(handler, "NotificationV1PreviewAudience")
 	}
 
 	response, err := handler(ctx, request)
@@ -5046,8 +9835,8 @@ func (sh *strictHandler) NotificationV1Create(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(NotificationV1CreateResponseObject); ok {
-		if err := validResponse.VisitNotificationV1CreateResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(NotificationV1PreviewAudienceResponseObject); ok {
+		if err := validResponse.VisitNotificationV1PreviewAudienceResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -5056,9 +9845,11 @@ func (sh *strictHandler) NotificationV1Create(ctx *gin.Context) {
 }
 
 // NotificationV1Dispatch operation middleware
-func (sh *strictHandler) NotificationV1Dispatch(ctx *gin.Context) {
+func (sh *strictHandler) NotificationV1Dispatch(ctx *gin.Context, params NotificationV1DispatchParams) {
 	var request NotificationV1DispatchRequestObject
 
+	request.Params = params
+
 	var body NotificationV1DispatchJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
@@ -5088,6 +9879,33 @@ func (sh *strictHandler) NotificationV1Dispatch(ctx *gin.Context) {
 	}
 }
 
+// NotificationV1Lifecycle operation middleware
+func (sh *strictHandler) NotificationV1Lifecycle(ctx *gin.Context, params NotificationV1LifecycleParams) {
+	var request NotificationV1LifecycleRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.NotificationV1Lifecycle(ctx, request.(NotificationV1LifecycleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "NotificationV1Lifecycle")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(NotificationV1LifecycleResponseObject); ok {
+		if err := validResponse.VisitNotificationV1LifecycleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // NotificationV1Delete operation middleware
 func (sh *strictHandler) NotificationV1Delete(ctx *gin.Context, id string) {
 	var request NotificationV1DeleteRequestObject
@@ -5115,11 +9933,39 @@ func (sh *strictHandler) NotificationV1Delete(ctx *gin.Context, id string) {
 	}
 }
 
+// NotificationV1Detail operation middleware
+func (sh *strictHandler) NotificationV1Detail(ctx *gin.Context, id string) {
+	var request NotificationV1DetailRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.NotificationV1Detail(ctx, request.(NotificationV1DetailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "NotificationV1Detail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(NotificationV1DetailResponseObject); ok {
+		if err := validResponse.VisitNotificationV1DetailResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // NotificationV1Update operation middleware
-func (sh *strictHandler) NotificationV1Update(ctx *gin.Context, id string) {
+func (sh *strictHandler) NotificationV1Update(ctx *gin.Context, id string, params NotificationV1UpdateParams) {
 	var request NotificationV1UpdateRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body NotificationV1UpdateJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
@@ -5292,9 +10138,11 @@ func (sh *strictHandler) RoomChangesV1List(ctx *gin.Context, params RoomChangesV
 }
 
 // RoomChangesV1Create operation middleware
-func (sh *strictHandler) RoomChangesV1Create(ctx *gin.Context) {
+func (sh *strictHandler) RoomChangesV1Create(ctx *gin.Context, params RoomChangesV1CreateParams) {
 	var request RoomChangesV1CreateRequestObject
 
+	request.Params = params
+
 	var body RoomChangesV1CreateJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
@@ -5466,10 +10314,11 @@ func (sh *strictHandler) RoomsV1Detail(ctx *gin.Context, id string) {
 }
 
 // RoomsV1Update operation middleware
-func (sh *strictHandler) RoomsV1Update(ctx *gin.Context, id string) {
+func (sh *strictHandler) RoomsV1Update(ctx *gin.Context, id string, params RoomsV1UpdateParams) {
 	var request RoomsV1UpdateRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	var body RoomsV1UpdateJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
@@ -5581,6 +10430,33 @@ func (sh *strictHandler) SubjectsV1Detail(ctx *gin.Context, id string) {
 	}
 }
 
+// SyllabusV1Detail operation middleware
+func (sh *strictHandler) SyllabusV1Detail(ctx *gin.Context, id string) {
+	var request SyllabusV1DetailRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SyllabusV1Detail(ctx, request.(SyllabusV1DetailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SyllabusV1Detail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(SyllabusV1DetailResponseObject); ok {
+		if err := validResponse.VisitSyllabusV1DetailResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // TimetableItemsV1List operation middleware
 func (sh *strictHandler) TimetableItemsV1List(ctx *gin.Context, params TimetableItemsV1ListParams) {
 	var request TimetableItemsV1ListRequestObject
@@ -5669,9 +10545,11 @@ func (sh *strictHandler) TimetableItemsV1Delete(ctx *gin.Context, id string) {
 }
 
 // UsersV1List operation middleware
-func (sh *strictHandler) UsersV1List(ctx *gin.Context) {
+func (sh *strictHandler) UsersV1List(ctx *gin.Context, params UsersV1ListParams) {
 	var request UsersV1ListRequestObject
 
+	request.Params = params
+
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.UsersV1List(ctx, request.(UsersV1ListRequestObject))
 	}
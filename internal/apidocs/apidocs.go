@@ -0,0 +1,77 @@
+package apidocs
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+)
+
+// Docs は OpenAPI 仕様を YAML / JSON で配信し、Swagger UI のページを返します。
+type Docs struct {
+	yaml []byte
+	json []byte
+}
+
+// New は spec の元の YAML と読み込み済みの仕様から Docs を作成します。
+// JSON は spec から生成するため、Servers を書き換える前の仕様を渡してください。
+func New(rawYAML []byte, spec *openapi3.T) (*Docs, error) {
+	encoded, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode OpenAPI spec as JSON: %w", err)
+	}
+	return &Docs{yaml: rawYAML, json: encoded}, nil
+}
+
+// YAML は /openapi.yaml のハンドラです。
+func (d *Docs) YAML() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/yaml", d.yaml)
+	}
+}
+
+// JSON は /openapi.json のハンドラです。
+func (d *Docs) JSON() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json", d.json)
+	}
+}
+
+// swaggerUIVersion は /docs で読み込む Swagger UI のバージョンです。
+const swaggerUIVersion = "5.17.14"
+
+var swaggerUI = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head>
+  <meta charset="utf-8">
+  <title>Admin BFF API</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@{{.Version}}/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@{{.Version}}/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({ url: {{.SpecURL}}, dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>
+`))
+
+// UI は specURL の仕様を表示する Swagger UI の /docs ハンドラです。
+func (d *Docs) UI(specURL string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Status(http.StatusOK)
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		if err := swaggerUI.Execute(c.Writer, map[string]string{
+			"Version": swaggerUIVersion,
+			"SpecURL": specURL,
+		}); err != nil {
+			_ = c.Error(err)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package apidocs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/openapi"
+)
+
+func TestDocs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	spec, err := openapi3.NewLoader().LoadFromData(openapi.Spec)
+	if err != nil {
+		t.Fatalf("load embedded spec: %v", err)
+	}
+	docs, err := New(openapi.Spec, spec)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	router := gin.New()
+	router.GET("/openapi.yaml", docs.YAML())
+	router.GET("/openapi.json", docs.JSON())
+	router.GET("/docs", docs.UI("/openapi.json"))
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("GET %s: status = %d", path, rec.Code)
+		}
+		return rec
+	}
+
+	if got := get("/openapi.yaml").Body.String(); got != string(openapi.Spec) {
+		t.Fatal("/openapi.yaml does not match the embedded spec")
+	}
+
+	var decoded struct {
+		OpenAPI string         `json:"openapi"`
+		Paths   map[string]any `json:"paths"`
+	}
+	if err := json.Unmarshal(get("/openapi.json").Body.Bytes(), &decoded); err != nil {
+		t.Fatalf("decode /openapi.json: %v", err)
+	}
+	if decoded.OpenAPI == "" || len(decoded.Paths) != spec.Paths.Len() {
+		t.Fatalf("unexpected /openapi.json: openapi=%q paths=%d", decoded.OpenAPI, len(decoded.Paths))
+	}
+
+	if body := get("/docs").Body.String(); !strings.Contains(body, `"/openapi.json"`) {
+		t.Fatalf("/docs does not reference the spec URL:\n%s", body)
+	}
+}
@@ -0,0 +1,70 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Entry は管理者による変更操作 1 件の監査ログです。
+type Entry struct {
+	Timestamp   time.Time `json:"timestamp"`
+	RequestID   string    `json:"requestId,omitempty"`
+	ActorUID    string    `json:"actorUid"`
+	OperationID string    `json:"operationId"`
+	Method      string    `json:"method"`
+	Path        string    `json:"path"`
+	// Query はリクエストのクエリ文字列です。dryRun のように同じパスで副作用の有無が変わる操作を区別するために記録します。
+	Query       string          `json:"query,omitempty"`
+	ResourceID  string          `json:"resourceId,omitempty"`
+	RequestBody json.RawMessage `json:"requestBody,omitempty"`
+	// StatusCode は BFF が返したステータスコードです。上流のステータスをそのまま反映します。
+	StatusCode int `json:"statusCode"`
+}
+
+// Filter は監査ログの検索条件です。ゼロ値の項目は条件に含めません。
+type Filter struct {
+	ActorUID    string
+	OperationID string
+	Since       time.Time
+	Limit       int
+}
+
+// Matches はエントリが検索条件を満たすかを返します。Limit は考慮しません。
+func (f Filter) Matches(e Entry) bool {
+	if f.ActorUID != "" && e.ActorUID != f.ActorUID {
+		return false
+	}
+	if f.OperationID != "" && e.OperationID != f.OperationID {
+		return false
+	}
+	if !f.Since.IsZero() && e.Timestamp.Before(f.Since) {
+		return false
+	}
+	return true
+}
+
+// Sink は監査ログの書き込み先です。
+type Sink interface {
+	Write(ctx context.Context, entry Entry) error
+}
+
+// Reader は記録済みの監査ログを新しい順に取得します。
+type Reader interface {
+	Recent(ctx context.Context, filter Filter) ([]Entry, error)
+}
+
+// MultiSink は複数の Sink に同じエントリを書き込みます。
+type MultiSink []Sink
+
+// Write は全ての Sink に書き込み、失敗したものがあればまとめて返します。
+func (m MultiSink) Write(ctx context.Context, entry Entry) error {
+	var errs []error
+	for _, sink := range m {
+		if err := sink.Write(ctx, entry); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,69 @@
+package audit
+
+import (
+	"context"
+	"sync"
+)
+
+// MemorySink は直近の監査ログを一定件数だけメモリ上に保持する Sink です。
+// 上限を超えた場合は古いエントリから破棄します。
+type MemorySink struct {
+	mu       sync.RWMutex
+	entries  []Entry
+	next     int
+	full     bool
+	capacity int
+}
+
+var (
+	_ Sink   = (*MemorySink)(nil)
+	_ Reader = (*MemorySink)(nil)
+)
+
+// NewMemorySink は capacity 件まで保持する MemorySink を作成します。
+func NewMemorySink(capacity int) *MemorySink {
+	if capacity <= 0 {
+		panic("capacity must be positive")
+	}
+	return &MemorySink{
+		entries:  make([]Entry, capacity),
+		capacity: capacity,
+	}
+}
+
+// Write はエントリを保持します。
+func (s *MemorySink) Write(_ context.Context, entry Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[s.next] = entry
+	s.next = (s.next + 1) % s.capacity
+	if s.next == 0 {
+		s.full = true
+	}
+	return nil
+}
+
+// Recent は検索条件を満たすエントリを新しい順に返します。
+func (s *MemorySink) Recent(_ context.Context, filter Filter) ([]Entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	size := s.next
+	if s.full {
+		size = s.capacity
+	}
+
+	result := make([]Entry, 0)
+	for i := 0; i < size; i++ {
+		entry := s.entries[(s.next-1-i+s.capacity)%s.capacity]
+		if !filter.Matches(entry) {
+			continue
+		}
+		result = append(result, entry)
+		if filter.Limit > 0 && len(result) >= filter.Limit {
+			break
+		}
+	}
+	return result, nil
+}
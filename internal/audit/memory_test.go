@@ -0,0 +1,58 @@
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemorySink_RecentReturnsNewestFirstWithinCapacity(t *testing.T) {
+	ctx := context.Background()
+	sink := NewMemorySink(3)
+	base := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, operationID := range []string{"op-1", "op-2", "op-3", "op-4"} {
+		if err := sink.Write(ctx, Entry{Timestamp: base.Add(time.Duration(i) * time.Minute), OperationID: operationID}); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+
+	entries, err := sink.Recent(ctx, Filter{})
+	if err != nil {
+		t.Fatalf("recent: %v", err)
+	}
+	var got []string
+	for _, e := range entries {
+		got = append(got, e.OperationID)
+	}
+	if len(got) != 3 || got[0] != "op-4" || got[1] != "op-3" || got[2] != "op-2" {
+		t.Fatalf("operationIds = %v, want [op-4 op-3 op-2]", got)
+	}
+}
+
+func TestMemorySink_RecentAppliesFilter(t *testing.T) {
+	ctx := context.Background()
+	sink := NewMemorySink(10)
+	base := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	_ = sink.Write(ctx, Entry{Timestamp: base, ActorUID: "alice", OperationID: "RoomsV1_create"})
+	_ = sink.Write(ctx, Entry{Timestamp: base.Add(time.Hour), ActorUID: "bob", OperationID: "RoomsV1_create"})
+	_ = sink.Write(ctx, Entry{Timestamp: base.Add(2 * time.Hour), ActorUID: "alice", OperationID: "RoomsV1_delete"})
+	_ = sink.Write(ctx, Entry{Timestamp: base.Add(3 * time.Hour), ActorUID: "alice", OperationID: "RoomsV1_create"})
+
+	entries, err := sink.Recent(ctx, Filter{ActorUID: "alice", OperationID: "RoomsV1_create", Since: base.Add(time.Minute)})
+	if err != nil {
+		t.Fatalf("recent: %v", err)
+	}
+	if len(entries) != 1 || !entries[0].Timestamp.Equal(base.Add(3*time.Hour)) {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+
+	entries, err = sink.Recent(ctx, Filter{ActorUID: "alice", Limit: 2})
+	if err != nil {
+		t.Fatalf("recent: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("len(entries) = %d, want 2", len(entries))
+	}
+}
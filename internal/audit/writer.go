@@ -0,0 +1,76 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// cloudLoggingEntry は Cloud Logging の構造化ログとして解釈される形式です。
+type cloudLoggingEntry struct {
+	Severity string            `json:"severity"`
+	Message  string            `json:"message"`
+	Labels   map[string]string `json:"logging.googleapis.com/labels"`
+	AuditLog Entry             `json:"auditLog"`
+}
+
+// WriterSink は監査ログを JSON Lines として io.Writer に書き込む Sink です。
+type WriterSink struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	wrap    func(Entry) any
+}
+
+var _ Sink = (*WriterSink)(nil)
+
+// NewStdoutSink は Cloud Logging の構造化ログ形式で標準出力に書き込む Sink を作成します。
+func NewStdoutSink() *WriterSink {
+	return NewCloudLoggingSink(os.Stdout)
+}
+
+// NewCloudLoggingSink は Cloud Logging の構造化ログ形式で w に書き込む Sink を作成します。
+func NewCloudLoggingSink(w io.Writer) *WriterSink {
+	return &WriterSink{
+		encoder: json.NewEncoder(w),
+		wrap: func(entry Entry) any {
+			return cloudLoggingEntry{
+				Severity: "NOTICE",
+				Message:  fmt.Sprintf("audit: %s by %s", entry.OperationID, entry.ActorUID),
+				Labels:   map[string]string{"type": "audit"},
+				AuditLog: entry,
+			}
+		},
+	}
+}
+
+// NewJSONLinesSink はエントリをそのまま 1 行 1 件の JSON として w に書き込む Sink を作成します。
+func NewJSONLinesSink(w io.Writer) *WriterSink {
+	return &WriterSink{
+		encoder: json.NewEncoder(w),
+		wrap:    func(entry Entry) any { return entry },
+	}
+}
+
+// NewFileSink は path に JSON Lines 形式で追記する Sink を作成します。
+// 返された io.Closer でファイルを閉じてください。
+func NewFileSink(path string) (*WriterSink, io.Closer, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	return NewJSONLinesSink(file), file, nil
+}
+
+// Write はエントリを 1 行の JSON として書き込みます。
+func (s *WriterSink) Write(_ context.Context, entry Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.encoder.Encode(s.wrap(entry)); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Entry はキャッシュしたレスポンスです。
+type Entry struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+type item struct {
+	entry     Entry
+	resource  string
+	expiresAt time.Time
+}
+
+// Cache は operationId ごとの TTL でレスポンスを保持するプロセス内キャッシュです。
+// エントリはリソース種別と紐づけて保存し、同じ種別の更新時にまとめて破棄します。
+type Cache struct {
+	ttls map[string]time.Duration
+	now  func() time.Time
+
+	mu    sync.Mutex
+	items map[string]item
+}
+
+// New は ttls に含まれる operationId のみをキャッシュする Cache を作成します。
+func New(ttls map[string]time.Duration) *Cache {
+	copied := make(map[string]time.Duration, len(ttls))
+	for operationID, ttl := range ttls {
+		if ttl > 0 {
+			copied[operationID] = ttl
+		}
+	}
+	return &Cache{ttls: copied, now: time.Now, items: make(map[string]item)}
+}
+
+// TTL は operationId のキャッシュ期間を返します。キャッシュ対象でない場合は false を返します。
+func (c *Cache) TTL(operationID string) (time.Duration, bool) {
+	ttl, ok := c.ttls[operationID]
+	return ttl, ok
+}
+
+// Get は有効期限内のエントリを返します。
+func (c *Cache) Get(key string) (Entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	it, ok := c.items[key]
+	if !ok {
+		return Entry{}, false
+	}
+	if !c.now().Before(it.expiresAt) {
+		delete(c.items, key)
+		return Entry{}, false
+	}
+	return it.entry, true
+}
+
+// Set は resource 種別のエントリとして ttl の間 entry を保存します。
+func (c *Cache) Set(key, resource string, entry Entry, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = item{entry: entry, resource: resource, expiresAt: c.now().Add(ttl)}
+}
+
+// Invalidate は resource 種別のエントリを全て破棄し、破棄した件数を返します。
+func (c *Cache) Invalidate(resource string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := 0
+	for key, it := range c.items {
+		if it.resource == resource {
+			delete(c.items, key)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Flush は全てのエントリを破棄し、破棄した件数を返します。
+func (c *Cache) Flush() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := len(c.items)
+	c.items = make(map[string]item)
+	return removed
+}
+
+// ResourceOf はリクエストパスからリソース種別を返します。
+// /v1/rooms と /v1/rooms/{id} はどちらも /v1/rooms になります。
+func ResourceOf(path string) string {
+	segments := strings.SplitN(strings.Trim(path, "/"), "/", 3)
+	if len(segments) < 2 {
+		return "/" + strings.Join(segments, "/")
+	}
+	return "/" + segments[0] + "/" + segments[1]
+}
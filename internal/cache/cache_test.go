@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Unix(0, 0)
+	c := New(map[string]time.Duration{"RoomsV1_list": time.Minute, "SubjectsV1_list": 0})
+	c.now = func() time.Time { return now }
+
+	if _, ok := c.TTL("SubjectsV1_list"); ok {
+		t.Fatal("operations with a zero TTL must not be cached")
+	}
+
+	c.Set("rooms", "/v1/rooms", Entry{StatusCode: 200, Body: []byte("[]")}, time.Minute)
+	c.Set("faculties", "/v1/faculties", Entry{StatusCode: 200}, time.Minute)
+	if _, ok := c.Get("rooms"); !ok {
+		t.Fatal("expected a cache hit")
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := c.Get("rooms"); ok {
+		t.Fatal("expected the entry to expire")
+	}
+
+	c.Set("rooms", "/v1/rooms", Entry{StatusCode: 200}, time.Minute)
+	if removed := c.Invalidate("/v1/rooms"); removed != 1 {
+		t.Fatalf("Invalidate() = %d, want 1", removed)
+	}
+	if removed := c.Flush(); removed != 1 {
+		t.Fatalf("Flush() = %d, want 1", removed)
+	}
+}
+
+func TestResourceOf(t *testing.T) {
+	tests := map[string]string{
+		"/v1/rooms":          "/v1/rooms",
+		"/v1/rooms/room-1":   "/v1/rooms",
+		"/v1/subjects/s-1/x": "/v1/subjects",
+		"/healthz":           "/healthz",
+	}
+	for path, want := range tests {
+		if got := ResourceOf(path); got != want {
+			t.Errorf("ResourceOf(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
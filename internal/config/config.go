@@ -0,0 +1,470 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/fun-dotto/admin-bff-api/internal/logging"
+	"github.com/fun-dotto/admin-bff-api/internal/server"
+	"github.com/fun-dotto/admin-bff-api/internal/telemetry"
+)
+
+// FileEnv は YAML 設定ファイルのパスを指定する環境変数です。
+const FileEnv = "CONFIG_FILE"
+
+// DefaultAllowedRoles は x-required-roles で使用しているロールです。
+var DefaultAllowedRoles = []string{"admin", "developer", "academicAffairs", "cafeteria"}
+
+// Config は BFF の起動時設定です。
+type Config struct {
+	Port int
+	// SpecPath は OpenAPI 仕様のパスです。空の場合はバイナリに埋め込んだ仕様を使用します。
+	SpecPath string
+	// DocsEnabled が true の場合は /docs で Swagger UI を提供します。
+	DocsEnabled   bool
+	LogLevel      slog.Level
+	TraceExporter telemetry.TraceExporter
+	// AllowedRoles は認可に使用するロールです。x-required-roles に含まれていても
+	// ここにないロールはアクセスを許可しません。
+	AllowedRoles []string
+	Server       Server
+	Upstreams    Upstreams
+	Dev          Dev
+	// CacheTTLs は operationId ごとのレスポンスキャッシュの期間です。含まれない operation はキャッシュしません。
+	CacheTTLs map[string]time.Duration
+	// NotificationTemplateFile は通知テンプレートを保存する JSON ファイルのパスです。
+	// 空の場合はメモリ上に保持し、再起動すると失われます。
+	NotificationTemplateFile string
+}
+
+// Dev はローカル開発モードの設定です。
+// 開発モードでは上流 API に ID トークンを付けずに HTTP で接続し、
+// Firebase Auth エミュレータまたは AuthSecret で署名した開発用トークンで認証します。
+type Dev struct {
+	Enabled bool
+	// AuthSecret は開発用トークンの HS256 署名鍵です。
+	AuthSecret string
+	// AuthEmulatorHost は Firebase Auth エミュレータのホストです。Firebase SDK も同じ環境変数を参照します。
+	AuthEmulatorHost string
+	// ProjectID はエミュレータに接続する Firebase プロジェクト ID です。
+	ProjectID string
+}
+
+// DefaultCacheTTLs は管理画面のプルダウンで繰り返し取得される参照データのキャッシュ期間です。
+var DefaultCacheTTLs = map[string]time.Duration{
+	"RoomsV1_list":     time.Minute,
+	"FacultiesV1_list": time.Minute,
+	"SubjectsV1_list":  time.Minute,
+}
+
+// minDevAuthSecretLength は開発用トークンの署名鍵の最小長です。
+const minDevAuthSecretLength = 32
+
+// Server は HTTP サーバーのタイムアウト設定です。
+type Server struct {
+	ReadHeaderTimeout   time.Duration
+	ReadTimeout         time.Duration
+	WriteTimeout        time.Duration
+	IdleTimeout         time.Duration
+	ShutdownGracePeriod time.Duration
+}
+
+// Upstreams は上流 API ごとの設定です。
+type Upstreams struct {
+	Academic     Upstream
+	Announcement Upstream
+	Funch        Upstream
+	User         Upstream
+}
+
+// Upstream は上流 API 1 件分の接続設定です。
+type Upstream struct {
+	URL     string
+	Timeout time.Duration
+	Retry   Retry
+	Breaker Breaker
+}
+
+// Retry は上流 API 呼び出しの再試行設定です。MaxAttempts が 1 の場合は再試行しません。
+type Retry struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+}
+
+// Breaker は上流 API ごとのサーキットブレーカーの設定です。
+// FailureThreshold 回連続で失敗すると OpenDuration の間、上流に送信せず 503 を返します。
+type Breaker struct {
+	FailureThreshold int
+	OpenDuration     time.Duration
+}
+
+// ServerOptions は server.Run に渡すオプションを返します。
+func (c *Config) ServerOptions() server.Options {
+	return server.Options{
+		Addr:                ":" + strconv.Itoa(c.Port),
+		ReadHeaderTimeout:   c.Server.ReadHeaderTimeout,
+		ReadTimeout:         c.Server.ReadTimeout,
+		WriteTimeout:        c.Server.WriteTimeout,
+		IdleTimeout:         c.Server.IdleTimeout,
+		ShutdownGracePeriod: c.Server.ShutdownGracePeriod,
+	}
+}
+
+// Load は CONFIG_FILE の YAML と環境変数から設定を読み込みます。環境変数が YAML より優先されます。
+// YAML には環境変数と同じキーを記述します。
+// 不正な値や不足している値は最初の 1 件で止めず、まとめて返します。
+func Load() (*Config, error) {
+	values, err := readFile(os.Getenv(FileEnv))
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys() {
+		if value, ok := os.LookupEnv(key); ok && value != "" {
+			values[key] = value
+		}
+	}
+	return Parse(values)
+}
+
+// Parse は環境変数名をキーとする値から設定を作成して検証します。
+func Parse(values map[string]string) (*Config, error) {
+	p := &parser{values: values}
+	defaults := server.DefaultOptions()
+
+	cfg := &Config{
+		Port:          p.int("PORT", 8080),
+		SpecPath:      p.string("OPENAPI_SPEC_PATH", ""),
+		DocsEnabled:   p.bool("DOCS_ENABLED"),
+		LogLevel:      p.logLevel("LOG_LEVEL"),
+		TraceExporter: p.traceExporter("OTEL_TRACES_EXPORTER"),
+		AllowedRoles:  p.list("ALLOWED_ROLES", DefaultAllowedRoles),
+		Server: Server{
+			ReadHeaderTimeout:   p.duration("SERVER_READ_HEADER_TIMEOUT", defaults.ReadHeaderTimeout),
+			ReadTimeout:         p.duration("SERVER_READ_TIMEOUT", defaults.ReadTimeout),
+			WriteTimeout:        p.duration("SERVER_WRITE_TIMEOUT", defaults.WriteTimeout),
+			IdleTimeout:         p.duration("SERVER_IDLE_TIMEOUT", defaults.IdleTimeout),
+			ShutdownGracePeriod: p.duration("SHUTDOWN_GRACE_PERIOD", defaults.ShutdownGracePeriod),
+		},
+		Upstreams: Upstreams{
+			Academic:     p.upstream("ACADEMIC_API"),
+			Announcement: p.upstream("ANNOUNCEMENT_API"),
+			Funch:        p.upstream("FUNCH_API"),
+			User:         p.upstream("USER_API"),
+		},
+		Dev: Dev{
+			Enabled:          p.bool("DEV_MODE"),
+			AuthSecret:       p.string("DEV_AUTH_SECRET", ""),
+			AuthEmulatorHost: p.string("FIREBASE_AUTH_EMULATOR_HOST", ""),
+			ProjectID:        p.string("GOOGLE_CLOUD_PROJECT", ""),
+		},
+		CacheTTLs:                p.durationMap("CACHE_TTLS", DefaultCacheTTLs),
+		NotificationTemplateFile: p.string("NOTIFICATION_TEMPLATE_FILE", ""),
+	}
+	p.validateDev(cfg)
+
+	if cfg.Port < 1 || cfg.Port > 65535 {
+		p.errorf("PORT must be between 1 and 65535")
+	}
+	if len(cfg.AllowedRoles) == 0 {
+		p.errorf("ALLOWED_ROLES must contain at least one role")
+	}
+
+	if err := errors.Join(p.errs...); err != nil {
+		return nil, fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	return cfg, nil
+}
+
+// readFile は YAML 設定ファイルを環境変数名をキーとする値に変換します。path が空の場合は空の値を返します。
+func readFile(path string) (map[string]string, error) {
+	values := make(map[string]string)
+	if path == "" {
+		return values, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	var errs []error
+	for key, value := range raw {
+		if !slices.Contains(keys(), key) {
+			errs = append(errs, fmt.Errorf("%s: unknown key %s", path, key))
+			continue
+		}
+		switch v := value.(type) {
+		case nil:
+		case []any:
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				items = append(items, fmt.Sprint(item))
+			}
+			values[key] = strings.Join(items, ",")
+		case map[string]any:
+			errs = append(errs, fmt.Errorf("%s: %s must be a scalar or a list", path, key))
+		default:
+			values[key] = fmt.Sprint(v)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// upstreamSuffixes は上流 API ごとの設定キーの接尾辞です。
+var upstreamSuffixes = []string{
+	"_URL",
+	"_TIMEOUT",
+	"_RETRY_MAX_ATTEMPTS",
+	"_RETRY_INITIAL_BACKOFF",
+	"_BREAKER_FAILURE_THRESHOLD",
+	"_BREAKER_OPEN_DURATION",
+}
+
+// keys は設定として読み込むキーの一覧を返します。
+func keys() []string {
+	keys := []string{
+		"PORT",
+		"OPENAPI_SPEC_PATH",
+		"DOCS_ENABLED",
+		"LOG_LEVEL",
+		"OTEL_TRACES_EXPORTER",
+		"ALLOWED_ROLES",
+		"SERVER_READ_HEADER_TIMEOUT",
+		"SERVER_READ_TIMEOUT",
+		"SERVER_WRITE_TIMEOUT",
+		"SERVER_IDLE_TIMEOUT",
+		"SHUTDOWN_GRACE_PERIOD",
+		"CACHE_TTLS",
+		"NOTIFICATION_TEMPLATE_FILE",
+		"DEV_MODE",
+		"DEV_AUTH_SECRET",
+		"FIREBASE_AUTH_EMULATOR_HOST",
+		"GOOGLE_CLOUD_PROJECT",
+		// Cloud Run が設定するため、開発モードの誤用の検出に使用する
+		"K_SERVICE",
+	}
+	for _, prefix := range []string{"ACADEMIC_API", "ANNOUNCEMENT_API", "FUNCH_API", "USER_API"} {
+		for _, suffix := range upstreamSuffixes {
+			keys = append(keys, prefix+suffix)
+		}
+	}
+	return keys
+}
+
+// parser は値を変換し、エラーを全て記録します。
+type parser struct {
+	values map[string]string
+	errs   []error
+}
+
+func (p *parser) errorf(format string, args ...any) {
+	p.errs = append(p.errs, fmt.Errorf(format, args...))
+}
+
+func (p *parser) string(key, fallback string) string {
+	if value := strings.TrimSpace(p.values[key]); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func (p *parser) int(key string, fallback int) int {
+	value := p.string(key, "")
+	if value == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		p.errorf("%s must be an integer: %q", key, value)
+		return fallback
+	}
+	return n
+}
+
+func (p *parser) bool(key string) bool {
+	value := p.string(key, "")
+	if value == "" {
+		return false
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		p.errorf("%s must be true or false: %q", key, value)
+		return false
+	}
+	return b
+}
+
+func (p *parser) duration(key string, fallback time.Duration) time.Duration {
+	value := p.string(key, "")
+	if value == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		p.errorf("%s must be a duration such as 30s: %q", key, value)
+		return fallback
+	}
+	if d <= 0 {
+		p.errorf("%s must be positive: %q", key, value)
+		return fallback
+	}
+	return d
+}
+
+func (p *parser) list(key string, fallback []string) []string {
+	value, ok := p.values[key]
+	if !ok {
+		return slices.Clone(fallback)
+	}
+	var items []string
+	for item := range strings.SplitSeq(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// durationMap は "key=30s,key2=1m" 形式の値を変換します。0s を指定したキーは無効として扱います。
+func (p *parser) durationMap(key string, fallback map[string]time.Duration) map[string]time.Duration {
+	value, ok := p.values[key]
+	if !ok {
+		return maps.Clone(fallback)
+	}
+	result := make(map[string]time.Duration)
+	for pair := range strings.SplitSeq(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, raw, found := strings.Cut(pair, "=")
+		if !found || strings.TrimSpace(name) == "" {
+			p.errorf("%s must be a list of name=duration pairs: %q", key, pair)
+			continue
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(raw))
+		if err != nil || d < 0 {
+			p.errorf("%s: %s must be a non-negative duration: %q", key, name, raw)
+			continue
+		}
+		result[strings.TrimSpace(name)] = d
+	}
+	return result
+}
+
+func (p *parser) logLevel(key string) slog.Level {
+	level, err := logging.ParseLevel(p.values[key])
+	if err != nil {
+		p.errorf("%s: %v", key, err)
+	}
+	return level
+}
+
+func (p *parser) traceExporter(key string) telemetry.TraceExporter {
+	exporter, err := telemetry.ParseTraceExporter(p.values[key])
+	if err != nil {
+		p.errorf("%s: %v", key, err)
+		return telemetry.TraceExporterNone
+	}
+	return exporter
+}
+
+func (p *parser) upstream(prefix string) Upstream {
+	u := Upstream{
+		URL:     p.string(prefix+"_URL", ""),
+		Timeout: p.duration(prefix+"_TIMEOUT", 30*time.Second),
+		Retry: Retry{
+			MaxAttempts:    p.int(prefix+"_RETRY_MAX_ATTEMPTS", 3),
+			InitialBackoff: p.duration(prefix+"_RETRY_INITIAL_BACKOFF", 200*time.Millisecond),
+		},
+		Breaker: Breaker{
+			FailureThreshold: p.int(prefix+"_BREAKER_FAILURE_THRESHOLD", 5),
+			OpenDuration:     p.duration(prefix+"_BREAKER_OPEN_DURATION", 30*time.Second),
+		},
+	}
+	if u.URL == "" {
+		p.errorf("%s_URL is required", prefix)
+	} else if parsed, err := url.Parse(u.URL); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		p.errorf("%s_URL must be an absolute URL: %q", prefix, u.URL)
+	}
+	if u.Retry.MaxAttempts < 1 {
+		p.errorf("%s_RETRY_MAX_ATTEMPTS must be at least 1", prefix)
+	}
+	if u.Breaker.FailureThreshold < 1 {
+		p.errorf("%s_BREAKER_FAILURE_THRESHOLD must be at least 1", prefix)
+	}
+	return u
+}
+
+// validateDev は開発モードの設定を検証します。
+// 開発モードは本番環境と判断できる設定と組み合わせられず、開発モード以外では開発用の認証を使用できません。
+func (p *parser) validateDev(cfg *Config) {
+	dev := cfg.Dev
+	if !dev.Enabled {
+		if dev.AuthSecret != "" {
+			p.errorf("DEV_AUTH_SECRET requires DEV_MODE=true")
+		}
+		if dev.AuthEmulatorHost != "" {
+			p.errorf("FIREBASE_AUTH_EMULATOR_HOST requires DEV_MODE=true")
+		}
+		// メモリ上のテンプレートは再起動やインスタンスごとに失われるため、開発モード以外では保存先を必須にする
+		if cfg.NotificationTemplateFile == "" {
+			p.errorf("NOTIFICATION_TEMPLATE_FILE is required unless DEV_MODE=true")
+		}
+		return
+	}
+
+	if dev.AuthSecret == "" && dev.AuthEmulatorHost == "" {
+		p.errorf("DEV_MODE requires DEV_AUTH_SECRET or FIREBASE_AUTH_EMULATOR_HOST")
+	}
+	if dev.AuthSecret != "" && len(dev.AuthSecret) < minDevAuthSecretLength {
+		p.errorf("DEV_AUTH_SECRET must be at least %d bytes", minDevAuthSecretLength)
+	}
+	if p.values["K_SERVICE"] != "" {
+		p.errorf("DEV_MODE must not be enabled on Cloud Run (K_SERVICE is set)")
+	}
+	for prefix, upstream := range map[string]Upstream{
+		"ACADEMIC_API":     cfg.Upstreams.Academic,
+		"ANNOUNCEMENT_API": cfg.Upstreams.Announcement,
+		"FUNCH_API":        cfg.Upstreams.Funch,
+		"USER_API":         cfg.Upstreams.User,
+	} {
+		parsed, err := url.Parse(upstream.URL)
+		if err != nil || parsed.Host == "" {
+			continue
+		}
+		if !isLocalHost(parsed.Hostname()) {
+			p.errorf("DEV_MODE requires local upstreams but %s_URL points to %s", prefix, parsed.Hostname())
+		}
+	}
+}
+
+// isLocalHost は host が開発環境内のホストかどうかを返します。
+// localhost、ループバック・プライベートアドレス、docker compose のサービス名のようなドットを含まない名前を許可します。
+func isLocalHost(host string) bool {
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || host == "host.docker.internal" {
+		return true
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.IsLoopback() || ip.IsPrivate()
+	}
+	return !strings.Contains(host, ".")
+}
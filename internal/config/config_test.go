@@ -0,0 +1,180 @@
+package config
+
+import (
+	"log/slog"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fun-dotto/admin-bff-api/internal/telemetry"
+)
+
+func validValues() map[string]string {
+	return map[string]string{
+		"ACADEMIC_API_URL":           "https://academic.example.com",
+		"ANNOUNCEMENT_API_URL":       "https://announcement.example.com",
+		"FUNCH_API_URL":              "https://funch.example.com",
+		"USER_API_URL":               "https://user.example.com",
+		"NOTIFICATION_TEMPLATE_FILE": "/var/lib/admin-bff/templates.json",
+	}
+}
+
+func TestParse_Defaults(t *testing.T) {
+	cfg, err := Parse(validValues())
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if cfg.Port != 8080 || cfg.SpecPath != "" || cfg.DocsEnabled {
+		t.Fatalf("unexpected defaults: %+v", cfg)
+	}
+	if cfg.LogLevel != slog.LevelInfo || cfg.TraceExporter != telemetry.TraceExporterNone {
+		t.Fatalf("unexpected defaults: %+v", cfg)
+	}
+	if !slices.Equal(cfg.AllowedRoles, DefaultAllowedRoles) {
+		t.Fatalf("AllowedRoles = %v", cfg.AllowedRoles)
+	}
+	if got := cfg.Upstreams.Academic; got.Timeout != 30*time.Second || got.Retry.MaxAttempts != 3 || got.Breaker.FailureThreshold != 5 {
+		t.Fatalf("Academic = %+v", got)
+	}
+	if got := cfg.ServerOptions().Addr; got != ":8080" {
+		t.Fatalf("Addr = %q", got)
+	}
+}
+
+func TestParse_ReportsAllErrors(t *testing.T) {
+	values := map[string]string{
+		"PORT":                        "eighty",
+		"LOG_LEVEL":                   "verbose",
+		"SERVER_WRITE_TIMEOUT":        "10",
+		"FUNCH_API_URL":               "funch.example.com",
+		"USER_API_URL":                "https://user.example.com",
+		"USER_API_RETRY_MAX_ATTEMPTS": "0",
+	}
+
+	_, err := Parse(values)
+	if err == nil {
+		t.Fatal("Parse() error = nil")
+	}
+	for _, want := range []string{
+		"PORT must be an integer",
+		"LOG_LEVEL",
+		"SERVER_WRITE_TIMEOUT must be a duration",
+		"ACADEMIC_API_URL is required",
+		"ANNOUNCEMENT_API_URL is required",
+		"FUNCH_API_URL must be an absolute URL",
+		"USER_API_RETRY_MAX_ATTEMPTS must be at least 1",
+		"NOTIFICATION_TEMPLATE_FILE is required unless DEV_MODE=true",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error does not mention %q:\n%v", want, err)
+		}
+	}
+}
+
+func TestLoad_EnvOverridesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	file := `
+PORT: 9090
+LOG_LEVEL: debug
+ALLOWED_ROLES: [admin, academicAffairs]
+ACADEMIC_API_URL: https://academic.example.com
+ANNOUNCEMENT_API_URL: https://announcement.example.com
+FUNCH_API_URL: https://funch.example.com
+USER_API_URL: https://user.example.com
+USER_API_TIMEOUT: 5s
+NOTIFICATION_TEMPLATE_FILE: /var/lib/admin-bff/templates.json
+`
+	if err := os.WriteFile(path, []byte(file), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	for _, key := range keys() {
+		t.Setenv(key, "")
+	}
+	t.Setenv(FileEnv, path)
+	t.Setenv("PORT", "8081")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Port != 8081 || cfg.LogLevel != slog.LevelDebug {
+		t.Fatalf("unexpected config: %+v", cfg)
+	}
+	if !slices.Equal(cfg.AllowedRoles, []string{"admin", "academicAffairs"}) {
+		t.Fatalf("AllowedRoles = %v", cfg.AllowedRoles)
+	}
+	if cfg.Upstreams.User.Timeout != 5*time.Second {
+		t.Fatalf("User.Timeout = %v", cfg.Upstreams.User.Timeout)
+	}
+}
+
+func TestLoad_RejectsUnknownFileKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("PROT: 8080\n"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	t.Setenv(FileEnv, path)
+
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "unknown key PROT") {
+		t.Fatalf("Load() error = %v", err)
+	}
+}
+
+func TestParse_DevMode(t *testing.T) {
+	local := map[string]string{
+		"DEV_MODE":             "true",
+		"DEV_AUTH_SECRET":      "0123456789abcdef0123456789abcdef",
+		"ACADEMIC_API_URL":     "http://localhost:8081",
+		"ANNOUNCEMENT_API_URL": "http://127.0.0.1:8082",
+		"FUNCH_API_URL":        "http://funch:8080",
+		"USER_API_URL":         "http://host.docker.internal:8084",
+	}
+	cfg, err := Parse(local)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if !cfg.Dev.Enabled || cfg.Dev.AuthSecret == "" {
+		t.Fatalf("Dev = %+v", cfg.Dev)
+	}
+
+	tests := []struct {
+		name      string
+		overrides map[string]string
+		want      string
+	}{
+		{
+			name:      "production upstream",
+			overrides: map[string]string{"USER_API_URL": "https://user-api-xxxx.a.run.app"},
+			want:      "USER_API_URL points to user-api-xxxx.a.run.app",
+		},
+		{
+			name:      "cloud run",
+			overrides: map[string]string{"K_SERVICE": "admin-bff-api"},
+			want:      "must not be enabled on Cloud Run",
+		},
+		{
+			name:      "no authentication",
+			overrides: map[string]string{"DEV_AUTH_SECRET": ""},
+			want:      "DEV_MODE requires DEV_AUTH_SECRET or FIREBASE_AUTH_EMULATOR_HOST",
+		},
+		{
+			name:      "dev secret without dev mode",
+			overrides: map[string]string{"DEV_MODE": "false"},
+			want:      "DEV_AUTH_SECRET requires DEV_MODE=true",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := maps.Clone(local)
+			maps.Copy(values, tt.overrides)
+			if _, err := Parse(values); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Parse() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
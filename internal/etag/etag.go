@@ -0,0 +1,42 @@
+package etag
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// Compute はレスポンスボディから強い ETag を計算します。
+func Compute(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + base64.RawURLEncoding.EncodeToString(sum[:]) + `"`
+}
+
+// MatchesIfNoneMatch は If-None-Match の値が etag と一致するかを弱い比較で判定します。
+func MatchesIfNoneMatch(header, etag string) bool {
+	return matches(header, etag, true)
+}
+
+// MatchesIfMatch は If-Match の値が etag と一致するかを強い比較で判定します。
+func MatchesIfMatch(header, etag string) bool {
+	return matches(header, etag, false)
+}
+
+func matches(header, etag string, weak bool) bool {
+	for candidate := range strings.SplitSeq(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+		if strings.HasPrefix(candidate, "W/") {
+			if !weak {
+				continue
+			}
+			candidate = strings.TrimPrefix(candidate, "W/")
+		}
+		if candidate == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
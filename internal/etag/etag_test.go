@@ -0,0 +1,30 @@
+package etag
+
+import "testing"
+
+func TestMatches(t *testing.T) {
+	tag := Compute([]byte(`{"room":{"id":"room-1"}}`))
+	if tag != Compute([]byte(`{"room":{"id":"room-1"}}`)) || tag == Compute([]byte(`{"room":{"id":"room-2"}}`)) {
+		t.Fatal("Compute must be deterministic and depend on the body")
+	}
+
+	tests := []struct {
+		header        string
+		wantNoneMatch bool
+		wantMatch     bool
+	}{
+		{header: tag, wantNoneMatch: true, wantMatch: true},
+		{header: `"other", ` + tag, wantNoneMatch: true, wantMatch: true},
+		{header: "W/" + tag, wantNoneMatch: true, wantMatch: false},
+		{header: "*", wantNoneMatch: true, wantMatch: true},
+		{header: `"other"`, wantNoneMatch: false, wantMatch: false},
+	}
+	for _, tt := range tests {
+		if got := MatchesIfNoneMatch(tt.header, tag); got != tt.wantNoneMatch {
+			t.Errorf("MatchesIfNoneMatch(%q) = %v, want %v", tt.header, got, tt.wantNoneMatch)
+		}
+		if got := MatchesIfMatch(tt.header, tag); got != tt.wantMatch {
+			t.Errorf("MatchesIfMatch(%q) = %v, want %v", tt.header, got, tt.wantMatch)
+		}
+	}
+}
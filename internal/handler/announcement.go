@@ -2,93 +2,98 @@ package handler
 
 import (
 	"net/http"
+	"slices"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
+	api "github.com/fun-dotto/admin-bff-api/generated"
 	"github.com/fun-dotto/admin-bff-api/generated/external/announcement_api"
-	"github.com/fun-dotto/admin-bff-api/internal/middleware"
 )
 
 // AnnouncementsV1List 一覧を取得する
-func (h *Handler) AnnouncementsV1List(c *gin.Context) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
+func (h *Handler) AnnouncementsV1List(c *gin.Context, params api.AnnouncementsV1ListParams) {
+	response, err := h.announcementClient.AnnouncementsV1ListWithResponse(c.Request.Context(), &announcement_api.AnnouncementsV1ListParams{
+		SortByDate:     (*announcement_api.FoundationV1SortDirection)(params.SortByDate),
+		FilterIsActive: params.FilterIsActive,
+	})
+	if err != nil {
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
-	response, err := h.announcementClient.AnnouncementsV1ListWithResponse(c.Request.Context(), nil)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if response.JSON200 == nil {
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
-	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+	now := h.now()
+	announcements := make([]api.AnnouncementServiceAnnouncement, 0, len(response.JSON200.Announcements))
+	for _, announcement := range response.JSON200.Announcements {
+		converted := toAnnouncement(announcement, now)
+		if params.Statuses != nil && !slices.Contains(*params.Statuses, converted.Status) {
+			continue
+		}
+		announcements = append(announcements, converted)
+	}
+
+	page, err := paginate(announcements, params.Limit, params.Cursor, params.Sort)
+	if err != nil {
+		respondInvalidPageParams(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, response.JSON200)
+	c.JSON(http.StatusOK, page.body("announcements"))
 }
 
 // AnnouncementsV1Detail 詳細を取得する
 func (h *Handler) AnnouncementsV1Detail(c *gin.Context, id string) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	response, err := h.announcementClient.AnnouncementsV1DetailWithResponse(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
-	c.JSON(http.StatusOK, response.JSON200)
+	c.JSON(http.StatusOK, gin.H{"announcement": toAnnouncement(response.JSON200.Announcement, h.now())})
 }
 
 // AnnouncementsV1Create 新規作成する
 func (h *Handler) AnnouncementsV1Create(c *gin.Context) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	var req announcement_api.AnnouncementRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondInvalidRequestBody(c, err)
 		return
 	}
 
 	response, err := h.announcementClient.AnnouncementsV1CreateWithResponse(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON201 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
-	c.JSON(http.StatusCreated, response.JSON201)
+	c.JSON(http.StatusCreated, gin.H{"announcement": toAnnouncement(response.JSON201.Announcement, h.now())})
 }
 
 // AnnouncementsV1Delete 削除する
 func (h *Handler) AnnouncementsV1Delete(c *gin.Context, id string) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	response, err := h.announcementClient.AnnouncementsV1DeleteWithResponse(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.StatusCode() != http.StatusNoContent {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
@@ -96,27 +101,79 @@ func (h *Handler) AnnouncementsV1Delete(c *gin.Context, id string) {
 }
 
 // AnnouncementsV1Update 更新する
-func (h *Handler) AnnouncementsV1Update(c *gin.Context, id string) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
+func (h *Handler) AnnouncementsV1Update(c *gin.Context, id string, params api.AnnouncementsV1UpdateParams) {
 	var req announcement_api.AnnouncementRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondInvalidRequestBody(c, err)
 		return
 	}
 
+	if params.IfMatch != nil {
+		current, err := h.announcementClient.AnnouncementsV1DetailWithResponse(c.Request.Context(), id)
+		if err != nil {
+			respondUpstreamTransportError(c, err)
+			return
+		}
+		if current.JSON200 == nil {
+			respondUpstreamError(c, current.StatusCode(), current.Body)
+			return
+		}
+		if !checkIfMatch(c, *params.IfMatch, announcementRepresentations(current.JSON200.Announcement)...) {
+			return
+		}
+	}
+
 	response, err := h.announcementClient.AnnouncementsV1UpdateWithResponse(c.Request.Context(), id, req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
-	c.JSON(http.StatusOK, response.JSON200)
+	c.JSON(http.StatusOK, gin.H{"announcement": toAnnouncement(response.JSON200.Announcement, h.now())})
+}
+
+// announcementRepresentations 詳細取得のレスポンスとして返しうる全ての表現を返す
+// status は現在時刻から導出するため、公開期間の境界を跨いだだけで If-Match が一致しなくならないよう全ての公開状態を候補にする
+func announcementRepresentations(announcement announcement_api.Announcement) []any {
+	statuses := []api.AnnouncementServiceAnnouncementStatus{
+		api.AnnouncementServiceAnnouncementStatusScheduled,
+		api.AnnouncementServiceAnnouncementStatusActive,
+		api.AnnouncementServiceAnnouncementStatusExpired,
+	}
+	representations := make([]any, 0, len(statuses))
+	for _, status := range statuses {
+		converted := toAnnouncement(announcement, time.Time{})
+		converted.Status = status
+		representations = append(representations, gin.H{"announcement": converted})
+	}
+	return representations
+}
+
+// toAnnouncement 上流のおしらせに公開状態を付与して BFF のレスポンス形式に変換する
+func toAnnouncement(announcement announcement_api.Announcement, now time.Time) api.AnnouncementServiceAnnouncement {
+	return api.AnnouncementServiceAnnouncement{
+		Id:             announcement.Id,
+		Title:          announcement.Title,
+		AvailableFrom:  announcement.AvailableFrom,
+		AvailableUntil: announcement.AvailableUntil,
+		Url:            announcement.Url,
+		Status:         announcementStatus(announcement.AvailableFrom, announcement.AvailableUntil, now),
+	}
+}
+
+// announcementStatus 公開期間と現在時刻から公開状態を判定する
+// availableUntil が未指定の場合は公開終了しないものとして扱う
+func announcementStatus(availableFrom time.Time, availableUntil *time.Time, now time.Time) api.AnnouncementServiceAnnouncementStatus {
+	if now.Before(availableFrom) {
+		return api.AnnouncementServiceAnnouncementStatusScheduled
+	}
+	if availableUntil != nil && !now.Before(*availableUntil) {
+		return api.AnnouncementServiceAnnouncementStatusExpired
+	}
+	return api.AnnouncementServiceAnnouncementStatusActive
 }
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	api "github.com/fun-dotto/admin-bff-api/generated"
+	"github.com/gin-gonic/gin"
+)
+
+func TestAnnouncementsV1List_ForwardsParamsAndFiltersByStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var gotQuery url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"announcements":[
+			{"id":"past","title":"Past","availableFrom":"2000-01-01T00:00:00Z","availableUntil":"2000-12-31T00:00:00Z","url":"https://example.com/past"},
+			{"id":"live","title":"Live","availableFrom":"2000-01-01T00:00:00Z","url":"https://example.com/live"},
+			{"id":"future","title":"Future","availableFrom":"2999-01-01T00:00:00Z","url":"https://example.com/future"}
+		]}`))
+	}))
+	defer server.Close()
+
+	h := newTestHandler(t, server.URL)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v1/announcements", nil)
+	setAdminClaim(c)
+
+	sortByDate := api.Desc
+	filterIsActive := false
+	statuses := []api.AnnouncementServiceAnnouncementStatus{api.AnnouncementServiceAnnouncementStatusActive, api.AnnouncementServiceAnnouncementStatusScheduled}
+	h.AnnouncementsV1List(c, api.AnnouncementsV1ListParams{
+		SortByDate:     &sortByDate,
+		FilterIsActive: &filterIsActive,
+		Statuses:       &statuses,
+	})
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if gotQuery.Get("sortByDate") != "desc" || gotQuery.Get("filterIsActive") != "false" {
+		t.Fatalf("query sortByDate = %q, filterIsActive = %q", gotQuery.Get("sortByDate"), gotQuery.Get("filterIsActive"))
+	}
+
+	var body struct {
+		Announcements []api.AnnouncementServiceAnnouncement `json:"announcements"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	if len(body.Announcements) != 2 {
+		t.Fatalf("unexpected response body: %s", rec.Body.String())
+	}
+	if body.Announcements[0].Id != "live" || body.Announcements[0].Status != api.AnnouncementServiceAnnouncementStatusActive {
+		t.Fatalf("announcements[0] = %+v", body.Announcements[0])
+	}
+	if body.Announcements[1].Id != "future" || body.Announcements[1].Status != api.AnnouncementServiceAnnouncementStatusScheduled {
+		t.Fatalf("announcements[1] = %+v", body.Announcements[1])
+	}
+}
+
+func TestAnnouncementStatus(t *testing.T) {
+	from := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 4, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		until *time.Time
+		now   time.Time
+		want  api.AnnouncementServiceAnnouncementStatus
+	}{
+		{name: "before availableFrom", until: &until, now: from.Add(-time.Second), want: api.AnnouncementServiceAnnouncementStatusScheduled},
+		{name: "at availableFrom", until: &until, now: from, want: api.AnnouncementServiceAnnouncementStatusActive},
+		{name: "at availableUntil", until: &until, now: until, want: api.AnnouncementServiceAnnouncementStatusExpired},
+		{name: "without availableUntil", until: nil, now: until.AddDate(1, 0, 0), want: api.AnnouncementServiceAnnouncementStatusActive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := announcementStatus(from, tt.until, tt.now); got != tt.want {
+				t.Fatalf("announcementStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,323 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	api "github.com/fun-dotto/admin-bff-api/generated"
+	"github.com/fun-dotto/admin-bff-api/generated/external/academic_api"
+	"github.com/fun-dotto/admin-bff-api/generated/external/user_api"
+)
+
+// maxAudienceDepth 条件式の入れ子の最大の深さ
+const maxAudienceDepth = 8
+
+// registrationLookupConcurrency 履修情報を取得する際に並行して呼び出す上流 API の数
+const registrationLookupConcurrency = 8
+
+var (
+	errEmptyAudience   = errors.New("every audience condition must specify at least one condition other than exclude; use allUsers to target all users")
+	errAudienceTooDeep = errors.New("audience is nested too deeply")
+)
+
+// userSet ユーザーIDの集合
+type userSet map[string]struct{}
+
+func newUserSet(ids []string) userSet {
+	set := make(userSet, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
+
+func (s userSet) intersect(other userSet) userSet {
+	result := userSet{}
+	for id := range s {
+		if _, ok := other[id]; ok {
+			result[id] = struct{}{}
+		}
+	}
+	return result
+}
+
+// sorted ユーザーIDを昇順に並べて返す
+func (s userSet) sorted() []string {
+	return slices.Sorted(maps.Keys(s))
+}
+
+// academicTerm 履修情報を取得する単位となる開講年度と開講時期
+type academicTerm struct {
+	year     int
+	semester academic_api.DottoFoundationV1CourseSemester
+}
+
+// audienceResolver 通知の対象ユーザーの条件式をユーザーIDに展開する
+// 上流 API から取得したユーザーと履修情報は、1 回の展開の間だけ保持して使い回す
+type audienceResolver struct {
+	academicClient *academic_api.ClientWithResponses
+	userClient     *user_api.ClientWithResponses
+
+	users []user_api.User
+	// terms 履修情報を取得済みの開講時期
+	terms map[academicTerm]bool
+	// registrants 科目IDごとの履修登録しているユーザー
+	registrants map[string]userSet
+}
+
+func (h *Handler) newAudienceResolver() *audienceResolver {
+	return &audienceResolver{
+		academicClient: h.academicClient,
+		userClient:     h.userClient,
+		terms:          map[academicTerm]bool{},
+		registrants:    map[string]userSet{},
+	}
+}
+
+// validateAudience 条件式が展開できる形になっているかを確認する
+// 条件を指定しない条件式は全ユーザーに展開されるため、誤って全員に送信しないよう入れ子を含めて受け付けない
+// 全ユーザーを対象とする場合は allUsers で明示する
+func validateAudience(audience api.NotificationAudience) error {
+	if audienceDepth(audience) > maxAudienceDepth {
+		return errAudienceTooDeep
+	}
+	return validateAudienceNode(audience)
+}
+
+func validateAudienceNode(a api.NotificationAudience) error {
+	if isEmptyAudience(a) {
+		return errEmptyAudience
+	}
+	children := []api.NotificationAudience{}
+	if a.Union != nil {
+		children = append(children, *a.Union...)
+	}
+	if a.Intersection != nil {
+		children = append(children, *a.Intersection...)
+	}
+	if a.Exclude != nil {
+		children = append(children, *a.Exclude)
+	}
+	for _, child := range children {
+		if err := validateAudienceNode(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isEmptyAudience exclude 以外の条件を 1 つも指定していないかを判定する
+func isEmptyAudience(a api.NotificationAudience) bool {
+	return (a.AllUsers == nil || !*a.AllUsers) &&
+		isEmpty(a.Grades) && isEmpty(a.Courses) && isEmpty(a.Classes) &&
+		isEmpty(a.SubjectIds) && isEmpty(a.UserIds) &&
+		isEmpty(a.Union) && isEmpty(a.Intersection)
+}
+
+func audienceDepth(a api.NotificationAudience) int {
+	depth := 0
+	if a.Exclude != nil {
+		depth = audienceDepth(*a.Exclude)
+	}
+	for _, children := range []*[]api.NotificationAudience{a.Union, a.Intersection} {
+		if children == nil {
+			continue
+		}
+		for _, child := range *children {
+			depth = max(depth, audienceDepth(child))
+		}
+	}
+	return depth + 1
+}
+
+func isEmpty[T any](values *[]T) bool {
+	return values == nil || len(*values) == 0
+}
+
+// resolve 条件式に一致するユーザーIDの集合を返す
+// 指定された条件は全て満たす必要があり、allUsers のみの場合は全ユーザーを対象とする
+func (r *audienceResolver) resolve(ctx context.Context, a api.NotificationAudience) (userSet, error) {
+	var result userSet
+	restrict := func(set userSet) {
+		if result == nil {
+			result = set
+		} else {
+			result = result.intersect(set)
+		}
+	}
+
+	if !isEmpty(a.Grades) || !isEmpty(a.Courses) || !isEmpty(a.Classes) {
+		users, err := r.allUsers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		set := userSet{}
+		for _, user := range users {
+			if matchesAny(a.Grades, user.Grade) && matchesAny(a.Courses, user.Course) && matchesAny(a.Classes, user.Class) {
+				set[user.Id] = struct{}{}
+			}
+		}
+		restrict(set)
+	}
+	if !isEmpty(a.SubjectIds) {
+		set, err := r.registrantsOf(ctx, *a.SubjectIds)
+		if err != nil {
+			return nil, err
+		}
+		restrict(set)
+	}
+	if !isEmpty(a.UserIds) {
+		restrict(newUserSet(*a.UserIds))
+	}
+	if !isEmpty(a.Union) {
+		union := userSet{}
+		for _, child := range *a.Union {
+			set, err := r.resolve(ctx, child)
+			if err != nil {
+				return nil, err
+			}
+			maps.Copy(union, set)
+		}
+		restrict(union)
+	}
+	if !isEmpty(a.Intersection) {
+		for _, child := range *a.Intersection {
+			set, err := r.resolve(ctx, child)
+			if err != nil {
+				return nil, err
+			}
+			restrict(set)
+		}
+	}
+
+	// validateAudience を通過した条件式では、ここで result が nil なのは allUsers のみを指定した場合に限る
+	if result == nil {
+		users, err := r.allUsers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = userSet{}
+		for _, user := range users {
+			result[user.Id] = struct{}{}
+		}
+	}
+	if a.Exclude != nil {
+		excluded, err := r.resolve(ctx, *a.Exclude)
+		if err != nil {
+			return nil, err
+		}
+		for id := range excluded {
+			delete(result, id)
+		}
+	}
+	return result, nil
+}
+
+// allUsers 全ユーザーを取得する
+func (r *audienceResolver) allUsers(ctx context.Context) ([]user_api.User, error) {
+	if r.users != nil {
+		return r.users, nil
+	}
+	response, err := r.userClient.UsersV1ListWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if response.JSON200 == nil {
+		return nil, &upstreamResponseError{StatusCode: response.StatusCode(), Body: response.Body}
+	}
+	r.users = response.JSON200.Users
+	if r.users == nil {
+		r.users = []user_api.User{}
+	}
+	return r.users, nil
+}
+
+// registrantsOf 指定した科目のいずれかを履修登録しているユーザーIDの集合を返す
+// 上流 API は科目から履修者を引けないため、科目の開講時期ごとに全ユーザーの履修情報を取得して突き合わせる
+func (r *audienceResolver) registrantsOf(ctx context.Context, subjectIDs []string) (userSet, error) {
+	for _, subjectID := range subjectIDs {
+		if _, ok := r.registrants[subjectID]; ok {
+			continue
+		}
+		response, err := r.academicClient.SubjectsV1DetailWithResponse(ctx, subjectID)
+		if err != nil {
+			return nil, err
+		}
+		if response.JSON200 == nil {
+			return nil, &upstreamResponseError{StatusCode: response.StatusCode(), Body: response.Body}
+		}
+		if _, err := r.registrantsOfSubject(ctx, response.JSON200.Subject); err != nil {
+			return nil, err
+		}
+	}
+
+	result := userSet{}
+	for _, subjectID := range subjectIDs {
+		maps.Copy(result, r.registrants[subjectID])
+	}
+	return result, nil
+}
+
+// registrantsOfSubject 科目を履修登録しているユーザーIDの集合を返す
+// 科目の開講年度と開講時期が分かっている場合は、科目詳細を取得せずに履修情報を突き合わせる
+func (r *audienceResolver) registrantsOfSubject(ctx context.Context, subject academic_api.Subject) (userSet, error) {
+	if err := r.loadRegistrations(ctx, academicTerm{year: subject.Year, semester: subject.Semester}); err != nil {
+		return nil, err
+	}
+	if _, ok := r.registrants[subject.Id]; !ok {
+		r.registrants[subject.Id] = userSet{}
+	}
+	return r.registrants[subject.Id], nil
+}
+
+// loadRegistrations 開講時期に履修登録されている全ユーザーの履修情報を取得し、科目ごとに記録する
+func (r *audienceResolver) loadRegistrations(ctx context.Context, term academicTerm) error {
+	if r.terms[term] {
+		return nil
+	}
+	users, err := r.allUsers(ctx)
+	if err != nil {
+		return err
+	}
+
+	var mu sync.Mutex
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(registrationLookupConcurrency)
+	for _, user := range users {
+		g.Go(func() error {
+			response, err := r.academicClient.CourseRegistrationsV1ListWithResponse(gctx, &academic_api.CourseRegistrationsV1ListParams{
+				UserId:    user.Id,
+				Year:      &term.year,
+				Semesters: []academic_api.DottoFoundationV1CourseSemester{term.semester},
+			})
+			if err != nil {
+				return err
+			}
+			if response.JSON200 == nil {
+				return &upstreamResponseError{StatusCode: response.StatusCode(), Body: response.Body}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, registration := range response.JSON200.CourseRegistrations {
+				set, ok := r.registrants[registration.Subject.Id]
+				if !ok {
+					set = userSet{}
+					r.registrants[registration.Subject.Id] = set
+				}
+				set[registration.UserId] = struct{}{}
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	r.terms[term] = true
+	return nil
+}
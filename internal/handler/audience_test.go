@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newAudienceUpstream ユーザー・科目・履修情報・通知作成を返す上流 API のモックを作成する
+func newAudienceUpstream(t *testing.T, created *[]string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/users", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"users":[
+			{"id":"user-1","email":"a@fun.ac.jp","grade":"B1","course":"InformationDesign","class":"A"},
+			{"id":"user-2","email":"b@fun.ac.jp","grade":"B1","course":"InformationSystem","class":"B"},
+			{"id":"user-3","email":"c@fun.ac.jp","grade":"B2","course":"InformationDesign","class":"A"},
+			{"id":"user-4","email":"d@fun.ac.jp","grade":"B3"}
+		]}`))
+	})
+	mux.HandleFunc("GET /v1/subjects/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"subject":{"id":"` + r.PathValue("id") + `","name":"Math","credit":2,"faculties":[],"year":2026,"semester":"Q1"}}`))
+	})
+	mux.HandleFunc("GET /v1/courseRegistrations", func(w http.ResponseWriter, r *http.Request) {
+		userID := r.URL.Query().Get("userId")
+		if r.URL.Query().Get("year") != "2026" || r.URL.Query().Get("semesters") != "Q1" {
+			t.Errorf("unexpected registration query: %s", r.URL.RawQuery)
+		}
+		registrations := `[]`
+		if userID == "user-2" || userID == "user-3" {
+			registrations = `[{"id":"reg-` + userID + `","userId":"` + userID + `","subject":{"id":"subject-1","name":"Math","credit":2,"faculties":[],"year":2026,"semester":"Q1"}}]`
+		}
+		_, _ = w.Write([]byte(`{"courseRegistrations":` + registrations + `}`))
+	})
+	mux.HandleFunc("POST /v1/notifications", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			TargetUserIds []string `json:"targetUserIds"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode notification request: %v", err)
+		}
+		*created = req.TargetUserIds
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"notification":{"id":"notification-1","title":"t","body":"b","notifyAfter":"2026-04-01T00:00:00Z","notifyBefore":"2026-04-02T00:00:00Z","targetUsers":[]}}`))
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNotificationV1PreviewAudience(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var created []string
+	h := newTestHandler(t, newAudienceUpstream(t, &created).URL)
+
+	tests := []struct {
+		name     string
+		audience string
+		want     int
+		status   int
+	}{
+		{name: "grade", audience: `{"grades":["B1"]}`, want: 2, status: http.StatusOK},
+		{name: "attributes are combined with AND", audience: `{"grades":["B1","B2"],"courses":["InformationDesign"]}`, want: 2, status: http.StatusOK},
+		{name: "subject", audience: `{"subjectIds":["subject-1"]}`, want: 2, status: http.StatusOK},
+		{name: "union", audience: `{"union":[{"grades":["B3"]},{"userIds":["user-1"]}]}`, want: 2, status: http.StatusOK},
+		{name: "intersection", audience: `{"intersection":[{"grades":["B1"]},{"subjectIds":["subject-1"]}]}`, want: 1, status: http.StatusOK},
+		{name: "all users except", audience: `{"allUsers":true,"exclude":{"grades":["B1"]}}`, want: 2, status: http.StatusOK},
+		{name: "empty", audience: `{}`, status: http.StatusBadRequest},
+		{name: "exclude only", audience: `{"exclude":{"grades":["B1"]}}`, status: http.StatusBadRequest},
+		{name: "empty union member", audience: `{"union":[{}]}`, status: http.StatusBadRequest},
+		{name: "empty intersection member", audience: `{"intersection":[{"grades":["B1"]},{}]}`, status: http.StatusBadRequest},
+		{name: "empty exclude", audience: `{"userIds":["user-1"],"exclude":{}}`, status: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/notifications/audience/preview", strings.NewReader(`{"audience":`+tt.audience+`}`))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			h.NotificationV1PreviewAudience(c)
+
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var body struct {
+				UserCount int `json:"userCount"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("unmarshal response: %v", err)
+			}
+			if body.UserCount != tt.want {
+				t.Fatalf("userCount = %d, want %d", body.UserCount, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotificationV1CreateForAudience(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var created []string
+	h := newTestHandler(t, newAudienceUpstream(t, &created).URL)
+
+	create := func(audience string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(rec)
+		c.Request = httptest.NewRequest(http.MethodPost, "/v1/notifications/audience", strings.NewReader(`{
+			"audience":`+audience+`,
+			"notification":{"title":"t","body":"b","notifyAfter":"2026-04-01T00:00:00Z","notifyBefore":"2026-04-02T00:00:00Z"}
+		}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		h.NotificationV1CreateForAudience(c)
+		return rec
+	}
+
+	rec := create(`{"subjectIds":["subject-1"],"exclude":{"userIds":["user-3"]}}`)
+	if rec.Code != http.StatusCreated || !strings.Contains(rec.Body.String(), `"userCount":1`) {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if !slices.Equal(created, []string{"user-2"}) {
+		t.Fatalf("targetUserIds = %v", created)
+	}
+
+	for _, audience := range []string{
+		`{"union":[{}]}`,
+		`{"intersection":[{}]}`,
+		`{"exclude":{"userIds":["user-1"]}}`,
+		`{"union":[{"userIds":["user-1"]},{"exclude":{"userIds":["user-1"]}}]}`,
+	} {
+		created = nil
+		rec = create(audience)
+		if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "INVALID_AUDIENCE") || created != nil {
+			t.Fatalf("%s: status = %d, created = %v", audience, rec.Code, created)
+		}
+	}
+
+	created = nil
+	rec = create(`{"allUsers":true,"exclude":{"userIds":["user-1"]}}`)
+	if rec.Code != http.StatusCreated || !slices.Equal(created, []string{"user-2", "user-3", "user-4"}) {
+		t.Fatalf("all users except: status = %d, created = %v", rec.Code, created)
+	}
+
+	created = nil
+	rec = create(`{"userIds":["user-1"],"exclude":{"userIds":["user-1"]}}`)
+	if rec.Code != http.StatusUnprocessableEntity || created != nil {
+		t.Fatalf("empty audience: status = %d, created = %v", rec.Code, created)
+	}
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	api "github.com/fun-dotto/admin-bff-api/generated"
+	"github.com/fun-dotto/admin-bff-api/internal/audit"
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+)
+
+// defaultAuditLogLimit limit 未指定時に返す監査ログの件数
+const defaultAuditLogLimit = 100
+
+// AuditLogsV1List 監査ログを新しい順に取得する
+func (h *Handler) AuditLogsV1List(c *gin.Context, params api.AuditLogsV1ListParams) {
+	filter := audit.Filter{Limit: defaultAuditLogLimit}
+	if params.ActorUid != nil {
+		filter.ActorUID = *params.ActorUid
+	}
+	if params.OperationId != nil {
+		filter.OperationID = *params.OperationId
+	}
+	if params.Since != nil {
+		filter.Since = *params.Since
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+
+	entries, err := h.auditLogs.Recent(c.Request.Context(), filter)
+	if err != nil {
+		problem.Respond(c, problem.New(http.StatusInternalServerError, "failed to read audit logs"))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"auditLogs": entries})
+}
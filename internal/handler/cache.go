@@ -0,0 +1,15 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CacheV1Flush 参照データのレスポンスキャッシュを全て破棄する
+func (h *Handler) CacheV1Flush(c *gin.Context) {
+	removed := h.responseCache.Flush()
+	slog.InfoContext(c.Request.Context(), "Response cache flushed", slog.Int("entries", removed))
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/fun-dotto/admin-bff-api/generated/external/academic_api"
+	"github.com/fun-dotto/admin-bff-api/generated/external/user_api"
+	"github.com/fun-dotto/admin-bff-api/internal/middleware"
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+)
+
+// notificationCreateOperationID 履修者への通知に必要な権限を定める operation
+// 休講などの作成は academicAffairs にも許可されているが、通知の作成は許可されていないため別に認可する
+const notificationCreateOperationID = "NotificationV1_create"
+
+// errorCodeClassAlreadyEnded 授業日を過ぎているため通知を作成しなかった場合のエラーコード
+const errorCodeClassAlreadyEnded = "CLASS_ALREADY_ENDED"
+
+// academicLocation 授業の日付を解釈するタイムゾーン
+var academicLocation = time.FixedZone("JST", 9*60*60)
+
+// classNotification 休講・補講・教室変更を履修者に知らせる通知の内容
+type classNotification struct {
+	subject academic_api.Subject
+	date    openapi_types.Date
+	title   string
+	body    string
+}
+
+// formatClassTime 授業の日付と時限を通知の文面で使う形式にする (例：4月1日 1限)
+func formatClassTime(date openapi_types.Date, period academic_api.DottoFoundationV1Period) string {
+	return fmt.Sprintf("%s %s限", date.Format("1月2日"), strings.TrimPrefix(string(period), "Period"))
+}
+
+// authorizeNotify 呼び出し元が通知を作成する権限を持つかを確認し、持たない場合は 403 のレスポンスを書き込む
+// 休講などを作成する前に呼び出し、通知できない場合は何も作成しない
+func (h *Handler) authorizeNotify(c *gin.Context) bool {
+	token, _ := middleware.GetFirebaseToken(c)
+	err := h.rolePolicy.Authorize(notificationCreateOperationID, token)
+	if err == nil {
+		return true
+	}
+	status, code := http.StatusForbidden, ""
+	var authErr *middleware.AuthenticationError
+	if errors.As(err, &authErr) {
+		status, code = authErr.StatusCode, authErr.Code
+	}
+	problem.Respond(c, problem.New(status, "notify requires permission to create notifications").WithCode(code))
+	return false
+}
+
+// notifyRegistrants 科目の履修者に通知を作成し、結果をレスポンスボディに追加する
+// 作成済みの休講などは取り消せないため、失敗してもエラーレスポンスにはせず notificationError として返す
+// 履修者がいない場合は通知を作成しない
+func (h *Handler) notifyRegistrants(ctx context.Context, body gin.H, n classNotification) {
+	// 授業日の終わりを過ぎた通知は意味がないため、送信期限を授業日の翌日 0 時とする
+	now := time.Now()
+	notifyBefore := time.Date(n.date.Year(), n.date.Month(), n.date.Day()+1, 0, 0, 0, 0, academicLocation)
+	if !notifyBefore.After(now) {
+		body["notificationError"] = problem.New(http.StatusUnprocessableEntity, "the class date has already passed; no notification was created").
+			WithCode(errorCodeClassAlreadyEnded)
+		return
+	}
+
+	registrants, err := h.newAudienceResolver().registrantsOfSubject(ctx, n.subject)
+	if err != nil {
+		body["notificationError"] = upstreamFailureProblem(err)
+		return
+	}
+	if len(registrants) == 0 {
+		return
+	}
+
+	req := user_api.NotificationRequest{
+		Title:         n.title,
+		Body:          n.body,
+		NotifyAfter:   now,
+		NotifyBefore:  notifyBefore,
+		TargetUserIds: registrants.sorted(),
+	}
+	if errs := validateNotificationRequest(req, ""); len(errs) > 0 {
+		body["notificationError"] = invalidNotificationProblem(errs)
+		return
+	}
+	response, err := h.userClient.NotificationV1CreateWithResponse(ctx, req)
+	if err != nil {
+		body["notificationError"] = upstreamFailureProblem(err)
+		return
+	}
+	if response.JSON201 == nil {
+		body["notificationError"] = upstreamFailureProblem(&upstreamResponseError{StatusCode: response.StatusCode(), Body: response.Body})
+		return
+	}
+	body["notificationId"] = response.JSON201.Notification.Id
+}
+
+// upstreamFailureProblem 上流 API 呼び出しの失敗をレスポンスボディに含める Problem に変換する
+func upstreamFailureProblem(err error) *problem.Problem {
+	e := translateUpstreamFailure(err)
+	return problem.New(e.StatusCode, e.Message).WithCode(e.Code)
+}
@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	firebaseauth "firebase.google.com/go/v4/auth"
+	"github.com/gin-gonic/gin"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	api "github.com/fun-dotto/admin-bff-api/generated"
+	"github.com/fun-dotto/admin-bff-api/generated/external/academic_api"
+	"github.com/fun-dotto/admin-bff-api/internal/audit"
+	"github.com/fun-dotto/admin-bff-api/internal/middleware"
+)
+
+func TestCancelledClassesV1CreateNotify(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var created []string
+	audienceURL, err := url.Parse(newAudienceUpstream(t, &created).URL)
+	if err != nil {
+		t.Fatalf("parse upstream url: %v", err)
+	}
+	createStatus := http.StatusCreated
+	createCalls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/cancelledClasses", func(w http.ResponseWriter, r *http.Request) {
+		createCalls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(createStatus)
+		if createStatus != http.StatusCreated {
+			_, _ = w.Write([]byte(`{"message":"already exists"}`))
+			return
+		}
+		var req struct {
+			Date string `json:"date"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		_, _ = w.Write([]byte(`{"cancelledClass":{"id":"cancelled-1","comment":"","date":"` + req.Date + `","period":"Period2",
+			"subject":{"id":"subject-1","name":"Math","credit":2,"faculties":[],"year":2026,"semester":"Q1"}}}`))
+	})
+	mux.Handle("/", httputil.NewSingleHostReverseProxy(audienceURL))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	h := newTestHandler(t, server.URL)
+	tomorrow := time.Now().In(academicLocation).AddDate(0, 0, 1).Format(time.DateOnly)
+	createOn := func(date string, notify *bool, role string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(rec)
+		c.Set(middleware.FirebaseTokenContextKey, &firebaseauth.Token{Claims: map[string]any{role: true}})
+		c.Request = httptest.NewRequest(http.MethodPost, "/v1/cancelledClasses", strings.NewReader(`{"subjectId":"subject-1","date":"`+date+`","period":"Period2","comment":""}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		h.CancelledClassesV1Create(c, api.CancelledClassesV1CreateParams{Notify: notify})
+		return rec
+	}
+	create := func(notify *bool, role string) *httptest.ResponseRecorder {
+		return createOn(tomorrow, notify, role)
+	}
+	notify := true
+
+	t.Run("without notify", func(t *testing.T) {
+		created = nil
+		rec := create(nil, "academicAffairs")
+		if rec.Code != http.StatusCreated || strings.Contains(rec.Body.String(), "notificationId") {
+			t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+		}
+		if created != nil {
+			t.Fatalf("notification created for %v", created)
+		}
+	})
+
+	t.Run("notify registrants", func(t *testing.T) {
+		created = nil
+		rec := create(&notify, "admin")
+		var body struct {
+			NotificationID string `json:"notificationId"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+			t.Fatalf("unmarshal response: %v", err)
+		}
+		if rec.Code != http.StatusCreated || body.NotificationID != "notification-1" {
+			t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+		}
+		if !slices.Equal(created, []string{"user-2", "user-3"}) {
+			t.Fatalf("targetUserIds = %v", created)
+		}
+	})
+
+	t.Run("audit records the created class", func(t *testing.T) {
+		created = nil
+		sink := audit.NewMemorySink(10)
+		router := gin.New()
+		router.Use(func(c *gin.Context) {
+			c.Set(middleware.FirebaseTokenContextKey, &firebaseauth.Token{UID: "admin-uid", Claims: map[string]any{"admin": true}})
+			c.Next()
+		}, middleware.Audit(sink))
+		router.POST("/v1/cancelledClasses", func(c *gin.Context) {
+			h.CancelledClassesV1Create(c, api.CancelledClassesV1CreateParams{Notify: &notify})
+		})
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/v1/cancelledClasses?notify=true", strings.NewReader(`{"subjectId":"subject-1","date":"`+tomorrow+`","period":"Period2","comment":""}`))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(rec, req)
+		if rec.Code != http.StatusCreated || !strings.Contains(rec.Body.String(), "notificationId") {
+			t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+		}
+
+		entries, err := sink.Recent(req.Context(), audit.Filter{})
+		if err != nil {
+			t.Fatalf("recent: %v", err)
+		}
+		if len(entries) != 1 || entries[0].ResourceID != "cancelled-1" {
+			t.Fatalf("entries = %+v", entries)
+		}
+	})
+
+	t.Run("class date has passed", func(t *testing.T) {
+		created = nil
+		rec := createOn("2026-04-01", &notify, "admin")
+		if rec.Code != http.StatusCreated || strings.Contains(rec.Body.String(), "notificationId") ||
+			!strings.Contains(rec.Body.String(), `"code":"CLASS_ALREADY_ENDED"`) {
+			t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+		}
+		if created != nil {
+			t.Fatalf("notification created for %v", created)
+		}
+	})
+
+	t.Run("notify without notification role", func(t *testing.T) {
+		created = nil
+		before := createCalls
+		rec := create(&notify, "academicAffairs")
+		if rec.Code != http.StatusForbidden || !strings.Contains(rec.Body.String(), "INSUFFICIENT_PERMISSIONS") {
+			t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+		}
+		if createCalls != before || created != nil {
+			t.Fatalf("create calls = %d, notification created for %v", createCalls-before, created)
+		}
+	})
+
+	t.Run("upstream create failed", func(t *testing.T) {
+		created = nil
+		createStatus = http.StatusConflict
+		defer func() { createStatus = http.StatusCreated }()
+		if rec := create(&notify, "admin"); rec.Code != http.StatusConflict {
+			t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+		}
+		if created != nil {
+			t.Fatalf("notification created for %v", created)
+		}
+	})
+}
+
+func TestFormatClassTime(t *testing.T) {
+	date := openapi_types.Date{Time: time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)}
+	if got := formatClassTime(date, academic_api.Period3); got != "4月1日 3限" {
+		t.Fatalf("formatClassTime = %q", got)
+	}
+}
@@ -7,45 +7,42 @@ import (
 
 	api "github.com/fun-dotto/admin-bff-api/generated"
 	"github.com/fun-dotto/admin-bff-api/generated/external/academic_api"
-	"github.com/fun-dotto/admin-bff-api/internal/middleware"
 )
 
 // FacultiesV1List 教員一覧を取得する
 func (h *Handler) FacultiesV1List(c *gin.Context, params api.FacultiesV1ListParams) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	response, err := h.academicClient.FacultiesV1ListWithResponse(c.Request.Context(), &academic_api.FacultiesV1ListParams{
 		Q: params.Q,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
-	c.JSON(http.StatusOK, response.JSON200)
+	page, err := paginate(response.JSON200.Faculties, params.Limit, params.Cursor, params.Sort)
+	if err != nil {
+		respondInvalidPageParams(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, page.body("faculties"))
 }
 
 // FacultiesV1Detail 教員を詳細取得する
 func (h *Handler) FacultiesV1Detail(c *gin.Context, id string) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	response, err := h.academicClient.FacultiesV1DetailWithResponse(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
@@ -54,24 +51,20 @@ func (h *Handler) FacultiesV1Detail(c *gin.Context, id string) {
 
 // FacultiesV1Create 教員を作成する
 func (h *Handler) FacultiesV1Create(c *gin.Context) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	var req academic_api.FacultyRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondInvalidRequestBody(c, err)
 		return
 	}
 
 	response, err := h.academicClient.FacultiesV1CreateWithResponse(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON201 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
@@ -79,25 +72,36 @@ func (h *Handler) FacultiesV1Create(c *gin.Context) {
 }
 
 // FacultiesV1Update 教員を更新する
-func (h *Handler) FacultiesV1Update(c *gin.Context, id string) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
+func (h *Handler) FacultiesV1Update(c *gin.Context, id string, params api.FacultiesV1UpdateParams) {
 	var req academic_api.FacultyRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondInvalidRequestBody(c, err)
 		return
 	}
 
+	if params.IfMatch != nil {
+		current, err := h.academicClient.FacultiesV1DetailWithResponse(c.Request.Context(), id)
+		if err != nil {
+			respondUpstreamTransportError(c, err)
+			return
+		}
+		if current.JSON200 == nil {
+			respondUpstreamError(c, current.StatusCode(), current.Body)
+			return
+		}
+		if !checkIfMatch(c, *params.IfMatch, current.JSON200) {
+			return
+		}
+	}
+
 	response, err := h.academicClient.FacultiesV1UpdateWithResponse(c.Request.Context(), id, req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
@@ -106,18 +110,14 @@ func (h *Handler) FacultiesV1Update(c *gin.Context, id string) {
 
 // FacultiesV1Delete 教員を削除する
 func (h *Handler) FacultiesV1Delete(c *gin.Context, id string) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	response, err := h.academicClient.FacultiesV1DeleteWithResponse(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.StatusCode() != http.StatusNoContent {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
@@ -7,51 +7,48 @@ import (
 
 	api "github.com/fun-dotto/admin-bff-api/generated"
 	"github.com/fun-dotto/admin-bff-api/generated/external/academic_api"
-	"github.com/fun-dotto/admin-bff-api/internal/middleware"
 )
 
 // FacultyRoomsV1List 教員室割当一覧を取得する
 func (h *Handler) FacultyRoomsV1List(c *gin.Context, params api.FacultyRoomsV1ListParams) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	response, err := h.academicClient.FacultyRoomsV1ListWithResponse(c.Request.Context(), &academic_api.FacultyRoomsV1ListParams{
 		Year: params.Year,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
+		return
+	}
+
+	page, err := paginate(response.JSON200.FacultyRooms, params.Limit, params.Cursor, params.Sort)
+	if err != nil {
+		respondInvalidPageParams(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, response.JSON200)
+	c.JSON(http.StatusOK, page.body("facultyRooms"))
 }
 
 // FacultyRoomsV1Create 教員室割当を作成する
 func (h *Handler) FacultyRoomsV1Create(c *gin.Context) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	var req academic_api.FacultyRoomRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondInvalidRequestBody(c, err)
 		return
 	}
 
 	response, err := h.academicClient.FacultyRoomsV1CreateWithResponse(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON201 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
@@ -60,18 +57,14 @@ func (h *Handler) FacultyRoomsV1Create(c *gin.Context) {
 
 // FacultyRoomsV1Delete 教員室割当を削除する
 func (h *Handler) FacultyRoomsV1Delete(c *gin.Context, id string) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	response, err := h.academicClient.FacultyRoomsV1DeleteWithResponse(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.StatusCode() != http.StatusNoContent {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
@@ -7,15 +7,10 @@ import (
 
 	api "github.com/fun-dotto/admin-bff-api/generated"
 	"github.com/fun-dotto/admin-bff-api/generated/external/user_api"
-	"github.com/fun-dotto/admin-bff-api/internal/middleware"
 )
 
 // FCMTokenV1List FCMトークン一覧を取得する
 func (h *Handler) FCMTokenV1List(c *gin.Context, params api.FCMTokenV1ListParams) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	response, err := h.userClient.FCMTokenV1ListWithResponse(c.Request.Context(), &user_api.FCMTokenV1ListParams{
 		UserIds:       params.UserIds,
 		Tokens:        params.Tokens,
@@ -23,38 +18,40 @@ func (h *Handler) FCMTokenV1List(c *gin.Context, params api.FCMTokenV1ListParams
 		UpdatedAtTo:   params.UpdatedAtTo,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
-	c.JSON(http.StatusOK, response.JSON200)
+	page, err := paginate(response.JSON200.FcmTokens, params.Limit, params.Cursor, params.Sort)
+	if err != nil {
+		respondInvalidPageParams(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, page.body("fcmTokens"))
 }
 
 // FCMTokenV1Upsert FCMトークンを作成または更新する
 func (h *Handler) FCMTokenV1Upsert(c *gin.Context) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	var req user_api.FCMTokenRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondInvalidRequestBody(c, err)
 		return
 	}
 
 	response, err := h.userClient.FCMTokenV1UpsertWithResponse(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
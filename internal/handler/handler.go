@@ -1,11 +1,17 @@
 package handler
 
 import (
+	"time"
+
 	api "github.com/fun-dotto/admin-bff-api/generated"
 	"github.com/fun-dotto/admin-bff-api/generated/external/academic_api"
 	"github.com/fun-dotto/admin-bff-api/generated/external/announcement_api"
 	"github.com/fun-dotto/admin-bff-api/generated/external/funch_api"
 	"github.com/fun-dotto/admin-bff-api/generated/external/user_api"
+	"github.com/fun-dotto/admin-bff-api/internal/audit"
+	"github.com/fun-dotto/admin-bff-api/internal/cache"
+	"github.com/fun-dotto/admin-bff-api/internal/middleware"
+	"github.com/fun-dotto/admin-bff-api/internal/notificationtemplate"
 )
 
 type Handler struct {
@@ -13,6 +19,13 @@ type Handler struct {
 	announcementClient *announcement_api.ClientWithResponses
 	funchClient        *funch_api.ClientWithResponses
 	userClient         *user_api.ClientWithResponses
+	auditLogs          audit.Reader
+	responseCache      *cache.Cache
+	templates          notificationtemplate.Store
+	// rolePolicy 1 つの operation の中で別の operation の権限が必要な処理を認可する
+	rolePolicy *middleware.RolePolicy
+	// now 現在時刻; テストで時刻から導出する項目を固定するために差し替える
+	now func() time.Time
 }
 
 func NewHandler(
@@ -20,6 +33,10 @@ func NewHandler(
 	announcementClient *announcement_api.ClientWithResponses,
 	funchClient *funch_api.ClientWithResponses,
 	userClient *user_api.ClientWithResponses,
+	auditLogs audit.Reader,
+	responseCache *cache.Cache,
+	templates notificationtemplate.Store,
+	rolePolicy *middleware.RolePolicy,
 ) *Handler {
 	if academicClient == nil {
 		panic("academicClient is required")
@@ -33,11 +50,28 @@ func NewHandler(
 	if userClient == nil {
 		panic("userClient is required")
 	}
+	if auditLogs == nil {
+		panic("auditLogs is required")
+	}
+	if responseCache == nil {
+		panic("responseCache is required")
+	}
+	if templates == nil {
+		panic("templates is required")
+	}
+	if rolePolicy == nil {
+		panic("rolePolicy is required")
+	}
 	return &Handler{
 		academicClient:     academicClient,
 		announcementClient: announcementClient,
 		funchClient:        funchClient,
 		userClient:         userClient,
+		auditLogs:          auditLogs,
+		responseCache:      responseCache,
+		templates:          templates,
+		rolePolicy:         rolePolicy,
+		now:                time.Now,
 	}
 }
 
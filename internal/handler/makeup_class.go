@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -12,68 +13,78 @@ import (
 
 // MakeupClassesV1List 補講一覧を取得する
 func (h *Handler) MakeupClassesV1List(c *gin.Context, params api.MakeupClassesV1ListParams) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	response, err := h.academicClient.MakeupClassesV1ListWithResponse(c.Request.Context(), &academic_api.MakeupClassesV1ListParams{
 		SubjectIds: params.SubjectIds,
 		From:       params.From,
 		Until:      params.Until,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
-	c.JSON(http.StatusOK, response.JSON200)
-}
-
-// MakeupClassesV1Create 補講を作成する
-func (h *Handler) MakeupClassesV1Create(c *gin.Context) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
+	page, err := paginate(response.JSON200.MakeupClasses, params.Limit, params.Cursor, params.Sort)
+	if err != nil {
+		respondInvalidPageParams(c, err)
 		return
 	}
 
+	c.JSON(http.StatusOK, page.body("makeupClasses"))
+}
+
+// MakeupClassesV1Create 補講を作成し、notify が指定されていれば履修者に通知する
+func (h *Handler) MakeupClassesV1Create(c *gin.Context, params api.MakeupClassesV1CreateParams) {
 	var req academic_api.MakeupClassRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondInvalidRequestBody(c, err)
+		return
+	}
+	notify := params.Notify != nil && *params.Notify
+	if notify && !h.authorizeNotify(c) {
 		return
 	}
 
 	response, err := h.academicClient.MakeupClassesV1CreateWithResponse(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON201 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
-	c.JSON(http.StatusCreated, response.JSON201)
+	created := response.JSON201.MakeupClass
+	middleware.SetAuditResourceID(c, created.Id)
+	body := gin.H{"makeupClass": created}
+	if notify {
+		h.notifyRegistrants(c.Request.Context(), body, classNotification{
+			subject: created.Subject,
+			date:    created.Date,
+			title:   "【補講】" + created.Subject.Name,
+			body:    fmt.Sprintf("%s に%sの補講を行います", formatClassTime(created.Date, created.Period), created.Subject.Name),
+		})
+	}
+
+	c.JSON(http.StatusCreated, body)
 }
 
 // MakeupClassesV1Delete 補講を削除する
 func (h *Handler) MakeupClassesV1Delete(c *gin.Context, id string) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	response, err := h.academicClient.MakeupClassesV1DeleteWithResponse(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.StatusCode() != http.StatusNoContent {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
@@ -1,131 +1,431 @@
 package handler
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"slices"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
 	api "github.com/fun-dotto/admin-bff-api/generated"
 	"github.com/fun-dotto/admin-bff-api/generated/external/user_api"
 	"github.com/fun-dotto/admin-bff-api/internal/middleware"
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
 )
 
 // NotificationV1List 通知一覧を取得する
 func (h *Handler) NotificationV1List(c *gin.Context, params api.NotificationV1ListParams) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	response, err := h.userClient.NotificationV1ListWithResponse(c.Request.Context(), &user_api.NotificationV1ListParams{
 		NotifyAtFrom: params.NotifyAtFrom,
 		NotifyAtTo:   params.NotifyAtTo,
 		IsNotified:   params.IsNotified,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
-	c.JSON(http.StatusOK, response.JSON200)
+	page, err := paginate(response.JSON200.Notifications, params.Limit, params.Cursor, params.Sort)
+	if err != nil {
+		respondInvalidPageParams(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, page.body("notifications"))
 }
 
 // NotificationV1Create 通知を作成する
 func (h *Handler) NotificationV1Create(c *gin.Context) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	var req user_api.NotificationRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondInvalidRequestBody(c, err)
+		return
+	}
+	if errs := validateNotificationRequest(req, ""); len(errs) > 0 {
+		respondInvalidNotification(c, errs)
 		return
 	}
 
 	response, err := h.userClient.NotificationV1CreateWithResponse(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON201 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
 	c.JSON(http.StatusCreated, response.JSON201)
 }
 
-// NotificationV1Update 通知を更新する
-func (h *Handler) NotificationV1Update(c *gin.Context, id string) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
+// NotificationV1Detail 通知を詳細取得する
+func (h *Handler) NotificationV1Detail(c *gin.Context, id string) {
+	notification, ok := h.findNotification(c, id)
+	if !ok {
 		return
 	}
 
+	c.JSON(http.StatusOK, gin.H{"notification": notification})
+}
+
+// findNotification 指定したIDの通知を取得する; 取得できない場合はエラーレスポンスを書き込んで false を返す
+// 上流に詳細取得 API がないため、一覧から対象の通知を探す
+func (h *Handler) findNotification(c *gin.Context, id string) (user_api.Notification, bool) {
+	response, err := h.userClient.NotificationV1ListWithResponse(c.Request.Context(), &user_api.NotificationV1ListParams{})
+	if err != nil {
+		respondUpstreamTransportError(c, err)
+		return user_api.Notification{}, false
+	}
+	if response.JSON200 == nil {
+		respondUpstreamError(c, response.StatusCode(), response.Body)
+		return user_api.Notification{}, false
+	}
+	index := slices.IndexFunc(response.JSON200.Notifications, func(n user_api.Notification) bool { return n.Id == id })
+	if index < 0 {
+		problem.Respond(c, problem.New(http.StatusNotFound, "notification not found").WithCode(errorCodeUpstreamNotFound))
+		return user_api.Notification{}, false
+	}
+	return response.JSON200.Notifications[index], true
+}
+
+// NotificationV1Update 通知を更新する
+func (h *Handler) NotificationV1Update(c *gin.Context, id string, params api.NotificationV1UpdateParams) {
 	var req user_api.NotificationRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondInvalidRequestBody(c, err)
 		return
 	}
+	if errs := validateNotificationRequest(req, ""); len(errs) > 0 {
+		respondInvalidNotification(c, errs)
+		return
+	}
+
+	if params.IfMatch != nil {
+		current, ok := h.findNotification(c, id)
+		if !ok {
+			return
+		}
+		if !checkIfMatch(c, *params.IfMatch, gin.H{"notification": current}) {
+			return
+		}
+	}
 
 	response, err := h.userClient.NotificationV1UpdateWithResponse(c.Request.Context(), id, req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
 	c.JSON(http.StatusOK, response.JSON200)
 }
 
-// NotificationV1Dispatch 通知を即時配信する
-func (h *Handler) NotificationV1Dispatch(c *gin.Context) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
+// NotificationV1CreateForAudience 条件式で指定した対象ユーザーに向けて通知を作成する
+func (h *Handler) NotificationV1CreateForAudience(c *gin.Context) {
+	var req api.NotificationV1CreateForAudienceJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequestBody(c, err)
+		return
+	}
+	if errs := validateNotificationRequest(toNotificationRequest(req.Notification, nil), "/notification"); len(errs) > 0 {
+		respondInvalidNotification(c, errs)
 		return
 	}
 
+	userIDs, ok := h.resolveAudience(c, req.Audience)
+	if !ok {
+		return
+	}
+	if len(userIDs) == 0 {
+		problem.Respond(c, problem.New(http.StatusUnprocessableEntity, "audience matches no users").WithCode(errorCodeEmptyAudience))
+		return
+	}
+
+	response, err := h.userClient.NotificationV1CreateWithResponse(c.Request.Context(), toNotificationRequest(req.Notification, userIDs))
+	if err != nil {
+		respondUpstreamTransportError(c, err)
+		return
+	}
+
+	if response.JSON201 == nil {
+		respondUpstreamError(c, response.StatusCode(), response.Body)
+		return
+	}
+
+	middleware.SetAuditResourceID(c, response.JSON201.Notification.Id)
+	c.JSON(http.StatusCreated, gin.H{
+		"notification": response.JSON201.Notification,
+		"userCount":    len(userIDs),
+	})
+}
+
+// NotificationV1PreviewAudience 条件式に一致する対象ユーザー数を取得する
+func (h *Handler) NotificationV1PreviewAudience(c *gin.Context) {
+	var req api.NotificationV1PreviewAudienceJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequestBody(c, err)
+		return
+	}
+
+	userIDs, ok := h.resolveAudience(c, req.Audience)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"userCount": len(userIDs)})
+}
+
+// 通知の対象ユーザーの条件式に関するエラーコード
+const (
+	errorCodeInvalidAudience = "INVALID_AUDIENCE"
+	errorCodeEmptyAudience   = "EMPTY_AUDIENCE"
+)
+
+// resolveAudience 条件式を対象ユーザーIDに展開する
+// 展開できなかった場合はエラーレスポンスを書き込んで false を返す
+func (h *Handler) resolveAudience(c *gin.Context, audience api.NotificationAudience) ([]string, bool) {
+	if err := validateAudience(audience); err != nil {
+		problem.Respond(c, problem.New(http.StatusBadRequest, err.Error()).WithCode(errorCodeInvalidAudience))
+		return nil, false
+	}
+
+	users, err := h.newAudienceResolver().resolve(c.Request.Context(), audience)
+	if err != nil {
+		respondUpstreamFailure(c, err)
+		return nil, false
+	}
+	return users.sorted(), true
+}
+
+// toNotificationRequest 通知の内容と対象ユーザーから上流の通知作成リクエストを組み立てる
+func toNotificationRequest(content api.NotificationContent, targetUserIDs []string) user_api.NotificationRequest {
+	return user_api.NotificationRequest{
+		Title:                content.Title,
+		Body:                 content.Body,
+		ImageUrl:             content.ImageUrl,
+		AnalyticsLabel:       content.AnalyticsLabel,
+		ApnsBadge:            content.ApnsBadge,
+		ApnsSound:            content.ApnsSound,
+		ApnsContentAvailable: content.ApnsContentAvailable,
+		AndroidChannelId:     content.AndroidChannelId,
+		AndroidPriority:      content.AndroidPriority,
+		AndroidTtlSeconds:    content.AndroidTtlSeconds,
+		WebpushLink:          content.WebpushLink,
+		Url:                  content.Url,
+		NotifyAfter:          content.NotifyAfter,
+		NotifyBefore:         content.NotifyBefore,
+		TargetUserIds:        targetUserIDs,
+	}
+}
+
+// NotificationV1Dispatch 通知を即時配信する; dryRun の場合は送信せずに送信される内容を返す
+func (h *Handler) NotificationV1Dispatch(c *gin.Context, params api.NotificationV1DispatchParams) {
 	var req user_api.NotificationV1DispatchJSONRequestBody
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondInvalidRequestBody(c, err)
+		return
+	}
+	if params.DryRun != nil && *params.DryRun {
+		h.previewDispatch(c, req.NotificationIds)
 		return
 	}
 
 	response, err := h.userClient.NotificationV1DispatchWithResponse(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
 	c.JSON(http.StatusOK, response.JSON200)
 }
 
-// NotificationV1Delete 通知を削除する
-func (h *Handler) NotificationV1Delete(c *gin.Context, id string) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
+// fcmTokenLookupBatchSize FCMトークンを取得する際に 1 回の上流 API 呼び出しで指定するユーザー数
+const fcmTokenLookupBatchSize = 100
+
+// previewDispatch 即時配信で送信される通知と対象ユーザーを返す
+// 上流の即時配信は送信状態や送信可能期間を問わないため、存在する通知は全て送信されるものとして扱う
+func (h *Handler) previewDispatch(c *gin.Context, notificationIDs []string) {
+	if len(notificationIDs) == 0 {
+		respondInvalidRequestBody(c, errors.New("notificationIds must not be empty"))
+		return
+	}
+
+	// 通知の詳細取得 API がないため、一覧から指定された通知を探す
+	response, err := h.userClient.NotificationV1ListWithResponse(c.Request.Context(), &user_api.NotificationV1ListParams{})
+	if err != nil {
+		respondUpstreamTransportError(c, err)
+		return
+	}
+	if response.JSON200 == nil {
+		respondUpstreamError(c, response.StatusCode(), response.Body)
+		return
+	}
+
+	byID := make(map[string]user_api.Notification, len(response.JSON200.Notifications))
+	for _, notification := range response.JSON200.Notifications {
+		byID[notification.Id] = notification
+	}
+	notifications := []user_api.Notification{}
+	missing := []string{}
+	targets := userSet{}
+	seen := map[string]bool{}
+	for _, id := range notificationIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		notification, ok := byID[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		notifications = append(notifications, notification)
+		for _, target := range notification.TargetUsers {
+			targets[target.UserId] = struct{}{}
+		}
+	}
+
+	targetUserIDs := targets.sorted()
+	reachable, err := h.usersWithFCMToken(c.Request.Context(), targetUserIDs)
+	if err != nil {
+		respondUpstreamFailure(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"notifications":          notifications,
+		"targetUserIds":          targetUserIDs,
+		"reachableUserCount":     len(reachable),
+		"missingNotificationIds": missing,
+	})
+}
+
+// usersWithFCMToken 指定したユーザーのうち FCMトークンを 1 つ以上持つユーザーIDの集合を返す
+// クエリパラメーターが長くなりすぎないよう、fcmTokenLookupBatchSize 人ずつ上流 API に問い合わせる
+func (h *Handler) usersWithFCMToken(ctx context.Context, userIDs []string) (userSet, error) {
+	result := userSet{}
+	for batch := range slices.Chunk(userIDs, fcmTokenLookupBatchSize) {
+		response, err := h.userClient.FCMTokenV1ListWithResponse(ctx, &user_api.FCMTokenV1ListParams{UserIds: &batch})
+		if err != nil {
+			return nil, err
+		}
+		if response.JSON200 == nil {
+			return nil, &upstreamResponseError{StatusCode: response.StatusCode(), Body: response.Body}
+		}
+		for _, token := range response.JSON200.FcmTokens {
+			result[token.UserId] = struct{}{}
+		}
+	}
+	return result, nil
+}
+
+// NotificationV1Lifecycle 通知ごとの配信状況を取得する
+func (h *Handler) NotificationV1Lifecycle(c *gin.Context, params api.NotificationV1LifecycleParams) {
+	response, err := h.userClient.NotificationV1ListWithResponse(c.Request.Context(), &user_api.NotificationV1ListParams{
+		NotifyAtFrom: params.NotifyAtFrom,
+		NotifyAtTo:   params.NotifyAtTo,
+	})
+	if err != nil {
+		respondUpstreamTransportError(c, err)
+		return
+	}
+
+	if response.JSON200 == nil {
+		respondUpstreamError(c, response.StatusCode(), response.Body)
+		return
+	}
+
+	now := time.Now()
+	statusCounts := map[string]int{}
+	lifecycles := make([]api.NotificationLifecycle, 0, len(response.JSON200.Notifications))
+	for _, notification := range response.JSON200.Notifications {
+		lifecycle := toNotificationLifecycle(notification, now)
+		statusCounts[string(lifecycle.Status)]++
+		if params.Statuses != nil && !slices.Contains(*params.Statuses, lifecycle.Status) {
+			continue
+		}
+		lifecycles = append(lifecycles, lifecycle)
+	}
+
+	page, err := paginate(lifecycles, params.Limit, params.Cursor, nil)
+	if err != nil {
+		respondInvalidPageParams(c, err)
 		return
 	}
 
+	body := page.body("notifications")
+	body["statusCounts"] = statusCounts
+	c.JSON(http.StatusOK, body)
+}
+
+// toNotificationLifecycle 対象ユーザーごとの通知送信日時を集計して配信状況を求める
+func toNotificationLifecycle(notification user_api.Notification, now time.Time) api.NotificationLifecycle {
+	lifecycle := api.NotificationLifecycle{
+		Id:                 notification.Id,
+		Title:              notification.Title,
+		NotifyAfter:        notification.NotifyAfter,
+		NotifyBefore:       notification.NotifyBefore,
+		UndeliveredUserIds: []string{},
+	}
+	for _, target := range notification.TargetUsers {
+		if target.NotifiedAt != nil {
+			lifecycle.DeliveredCount++
+		} else {
+			lifecycle.UndeliveredUserIds = append(lifecycle.UndeliveredUserIds, target.UserId)
+		}
+	}
+	lifecycle.PendingCount = len(lifecycle.UndeliveredUserIds)
+	lifecycle.Status = notificationDeliveryStatus(lifecycle.DeliveredCount, lifecycle.PendingCount, notification.NotifyAfter, notification.NotifyBefore, now)
+	return lifecycle
+}
+
+// notificationDeliveryStatus 配信済み・未配信の対象ユーザー数と通知予定期間から配信状況を判定する
+// 通知予定期間を過ぎると上流は送信しないため、未配信のユーザーが残っていても sending にはならない
+func notificationDeliveryStatus(delivered, pending int, notifyAfter, notifyBefore, now time.Time) api.NotificationDeliveryStatus {
+	switch {
+	case delivered == 0 && pending == 0:
+		return api.NotificationDeliveryStatusDraft
+	case pending == 0:
+		return api.NotificationDeliveryStatusDelivered
+	case now.Before(notifyAfter) && delivered == 0:
+		return api.NotificationDeliveryStatusScheduled
+	case !now.After(notifyBefore):
+		return api.NotificationDeliveryStatusSending
+	case delivered > 0:
+		return api.NotificationDeliveryStatusPartiallyDelivered
+	default:
+		return api.NotificationDeliveryStatusExpired
+	}
+}
+
+// NotificationV1Delete 通知を削除する
+func (h *Handler) NotificationV1Delete(c *gin.Context, id string) {
 	response, err := h.userClient.NotificationV1DeleteWithResponse(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.StatusCode() != http.StatusNoContent {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
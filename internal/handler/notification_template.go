@@ -0,0 +1,243 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	api "github.com/fun-dotto/admin-bff-api/generated"
+	"github.com/fun-dotto/admin-bff-api/internal/notificationtemplate"
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+)
+
+// 通知テンプレートに関するエラーコード
+const (
+	errorCodeTemplateNotFound         = "TEMPLATE_NOT_FOUND"
+	errorCodeTemplateNameConflict     = "TEMPLATE_NAME_CONFLICT"
+	errorCodeMissingTemplateVariables = "MISSING_TEMPLATE_VARIABLES"
+)
+
+// notificationTemplateResponse テンプレートにプレースホルダーの一覧を付与したレスポンス形式
+type notificationTemplateResponse struct {
+	notificationtemplate.Template
+	Placeholders []string `json:"placeholders"`
+}
+
+func toNotificationTemplateResponse(template notificationtemplate.Template) notificationTemplateResponse {
+	placeholders := template.Placeholders()
+	if placeholders == nil {
+		placeholders = []string{}
+	}
+	return notificationTemplateResponse{Template: template, Placeholders: placeholders}
+}
+
+// NotificationTemplatesV1List 通知テンプレートの一覧を取得する
+func (h *Handler) NotificationTemplatesV1List(c *gin.Context) {
+	templates, err := h.templates.List(c.Request.Context())
+	if err != nil {
+		respondTemplateStoreError(c, err)
+		return
+	}
+
+	response := make([]notificationTemplateResponse, 0, len(templates))
+	for _, template := range templates {
+		response = append(response, toNotificationTemplateResponse(template))
+	}
+	c.JSON(http.StatusOK, gin.H{"templates": response})
+}
+
+// NotificationTemplatesV1Create 通知テンプレートを作成する
+func (h *Handler) NotificationTemplatesV1Create(c *gin.Context) {
+	var req api.NotificationTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequestBody(c, err)
+		return
+	}
+
+	now := time.Now()
+	template := fromNotificationTemplateRequest(req)
+	template.ID = notificationtemplate.NewID()
+	template.CreatedAt = now
+	template.UpdatedAt = now
+	if err := h.templates.Create(c.Request.Context(), template); err != nil {
+		respondTemplateStoreError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"template": toNotificationTemplateResponse(template)})
+}
+
+// NotificationTemplatesV1Detail 通知テンプレートを取得する
+func (h *Handler) NotificationTemplatesV1Detail(c *gin.Context, id string) {
+	template, err := h.templates.Get(c.Request.Context(), id)
+	if err != nil {
+		respondTemplateStoreError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"template": toNotificationTemplateResponse(template)})
+}
+
+// NotificationTemplatesV1Update 通知テンプレートを更新する
+func (h *Handler) NotificationTemplatesV1Update(c *gin.Context, id string) {
+	var req api.NotificationTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequestBody(c, err)
+		return
+	}
+
+	current, err := h.templates.Get(c.Request.Context(), id)
+	if err != nil {
+		respondTemplateStoreError(c, err)
+		return
+	}
+
+	template := fromNotificationTemplateRequest(req)
+	template.ID = id
+	template.CreatedAt = current.CreatedAt
+	template.UpdatedAt = time.Now()
+	if err := h.templates.Update(c.Request.Context(), template); err != nil {
+		respondTemplateStoreError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"template": toNotificationTemplateResponse(template)})
+}
+
+// NotificationTemplatesV1Delete 通知テンプレートを削除する
+func (h *Handler) NotificationTemplatesV1Delete(c *gin.Context, id string) {
+	if err := h.templates.Delete(c.Request.Context(), id); err != nil {
+		respondTemplateStoreError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// NotificationTemplatesV1CreateNotification 通知テンプレートの変数を埋めて通知を作成する
+func (h *Handler) NotificationTemplatesV1CreateNotification(c *gin.Context, id string) {
+	var req api.NotificationTemplateRenderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondInvalidRequestBody(c, err)
+		return
+	}
+	if (req.TargetUserIds == nil) == (req.Audience == nil) {
+		respondInvalidRequestBody(c, errors.New("specify exactly one of targetUserIds or audience"))
+		return
+	}
+
+	template, err := h.templates.Get(c.Request.Context(), id)
+	if err != nil {
+		respondTemplateStoreError(c, err)
+		return
+	}
+
+	rendered, err := template.Render(req.Variables)
+	var missing *notificationtemplate.MissingVariablesError
+	if errors.As(err, &missing) {
+		fieldErrors := make([]problem.FieldError, 0, len(missing.Names))
+		for _, name := range missing.Names {
+			fieldErrors = append(fieldErrors, problem.FieldError{
+				Field:   "/variables/" + name,
+				In:      "body",
+				Message: "required by the template",
+			})
+		}
+		problem.Respond(c, problem.New(http.StatusUnprocessableEntity, err.Error()).
+			WithCode(errorCodeMissingTemplateVariables).
+			WithErrors(fieldErrors))
+		return
+	}
+	if err != nil {
+		problem.Respond(c, problem.New(http.StatusInternalServerError, "failed to render notification template"))
+		return
+	}
+
+	content := api.NotificationContent{
+		Title:                rendered.Title,
+		Body:                 rendered.Body,
+		ImageUrl:             rendered.ImageURL,
+		Url:                  rendered.URL,
+		WebpushLink:          rendered.WebpushLink,
+		AnalyticsLabel:       rendered.AnalyticsLabel,
+		ApnsBadge:            rendered.ApnsBadge,
+		ApnsSound:            rendered.ApnsSound,
+		ApnsContentAvailable: rendered.ApnsContentAvailable,
+		AndroidChannelId:     rendered.AndroidChannelID,
+		AndroidPriority:      rendered.AndroidPriority,
+		AndroidTtlSeconds:    rendered.AndroidTTLSeconds,
+		NotifyAfter:          req.NotifyAfter,
+		NotifyBefore:         req.NotifyBefore,
+	}
+	// notifyAfter と notifyBefore 以外はテンプレートの項目のため、変数を埋めた後の値を検証する
+	if errs := validateNotificationRequest(toNotificationRequest(content, nil), ""); len(errs) > 0 {
+		for i := range errs {
+			if errs[i].Field != "/notifyAfter" {
+				errs[i].In = "template"
+			}
+		}
+		respondInvalidNotification(c, errs)
+		return
+	}
+
+	var targetUserIDs []string
+	if req.Audience != nil {
+		var ok bool
+		if targetUserIDs, ok = h.resolveAudience(c, *req.Audience); !ok {
+			return
+		}
+		if len(targetUserIDs) == 0 {
+			problem.Respond(c, problem.New(http.StatusUnprocessableEntity, "audience matches no users").WithCode(errorCodeEmptyAudience))
+			return
+		}
+	} else {
+		targetUserIDs = *req.TargetUserIds
+	}
+
+	response, err := h.userClient.NotificationV1CreateWithResponse(c.Request.Context(), toNotificationRequest(content, targetUserIDs))
+	if err != nil {
+		respondUpstreamTransportError(c, err)
+		return
+	}
+
+	if response.JSON201 == nil {
+		respondUpstreamError(c, response.StatusCode(), response.Body)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.JSON201)
+}
+
+// fromNotificationTemplateRequest リクエストからテンプレートを組み立てる; ID と日時は呼び出し元で設定する
+func fromNotificationTemplateRequest(req api.NotificationTemplateRequest) notificationtemplate.Template {
+	return notificationtemplate.Template{
+		Name:                 req.Name,
+		Description:          req.Description,
+		Title:                req.Title,
+		Body:                 req.Body,
+		ImageURL:             req.ImageUrl,
+		URL:                  req.Url,
+		WebpushLink:          req.WebpushLink,
+		AnalyticsLabel:       req.AnalyticsLabel,
+		ApnsBadge:            req.ApnsBadge,
+		ApnsSound:            req.ApnsSound,
+		ApnsContentAvailable: req.ApnsContentAvailable,
+		AndroidChannelID:     req.AndroidChannelId,
+		AndroidPriority:      req.AndroidPriority,
+		AndroidTTLSeconds:    req.AndroidTtlSeconds,
+	}
+}
+
+// respondTemplateStoreError テンプレートの保存先から返されたエラーのレスポンスを書き込む
+func respondTemplateStoreError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, notificationtemplate.ErrNotFound):
+		problem.Respond(c, problem.New(http.StatusNotFound, err.Error()).WithCode(errorCodeTemplateNotFound))
+	case errors.Is(err, notificationtemplate.ErrNameConflict):
+		problem.Respond(c, problem.New(http.StatusConflict, err.Error()).WithCode(errorCodeTemplateNameConflict))
+	default:
+		problem.Respond(c, problem.New(http.StatusInternalServerError, "failed to access notification templates"))
+	}
+}
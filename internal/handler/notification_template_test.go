@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestNotificationTemplatesV1CreateNotification(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var gotRequest struct {
+		Title         string   `json:"title"`
+		Body          string   `json:"body"`
+		Url           string   `json:"url"`
+		TargetUserIds []string `json:"targetUserIds"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&gotRequest); err != nil {
+			t.Errorf("decode notification request: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"notification":{"id":"notification-1","title":"t","body":"b","notifyAfter":"2026-04-01T00:00:00Z","notifyBefore":"2026-04-02T00:00:00Z","targetUsers":[]}}`))
+	}))
+	defer server.Close()
+
+	h := newTestHandler(t, server.URL)
+	serve := func(method, target, body string, handle func(c *gin.Context)) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(rec)
+		c.Request = httptest.NewRequest(method, target, strings.NewReader(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		handle(c)
+		return rec
+	}
+
+	rec := serve(http.MethodPost, "/v1/notificationTemplates", `{
+		"name":"休講",
+		"title":"{subject} 休講のお知らせ",
+		"body":"{date} {period} の {subject} は休講です",
+		"url":"https://example.com/subjects/{subjectId}"
+	}`, h.NotificationTemplatesV1Create)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create template: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	var created struct {
+		Template struct {
+			ID           string   `json:"id"`
+			Placeholders []string `json:"placeholders"`
+		} `json:"template"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("unmarshal template: %v", err)
+	}
+	if len(created.Template.Placeholders) != 4 {
+		t.Fatalf("placeholders = %v", created.Template.Placeholders)
+	}
+	id := created.Template.ID
+
+	if rec := serve(http.MethodPost, "/v1/notificationTemplates", `{"name":"休講","title":"t","body":"b"}`, h.NotificationTemplatesV1Create); rec.Code != http.StatusConflict {
+		t.Fatalf("duplicate name: status = %d", rec.Code)
+	}
+
+	createNotification := func(body string) *httptest.ResponseRecorder {
+		return serve(http.MethodPost, "/v1/notificationTemplates/"+id+"/notifications", body, func(c *gin.Context) {
+			h.NotificationTemplatesV1CreateNotification(c, id)
+		})
+	}
+
+	rec = createNotification(`{"variables":{"subject":"解析学"},"notifyAfter":"2026-04-01T00:00:00Z","notifyBefore":"2026-04-02T00:00:00Z","targetUserIds":["user-1"]}`)
+	if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(rec.Body.String(), `"field":"/variables/date"`) {
+		t.Fatalf("missing variables: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+
+	rec = createNotification(`{"variables":{"subject":"解析学"},"notifyAfter":"2026-04-01T00:00:00Z","notifyBefore":"2026-04-02T00:00:00Z"}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("missing target: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+
+	rec = createNotification(`{
+		"variables":{"subject":"解析学","date":"4/1","period":"1限","subjectId":"s1"},
+		"notifyAfter":"2026-04-01T00:00:00Z","notifyBefore":"2026-04-02T00:00:00Z",
+		"targetUserIds":["user-1","user-2"]
+	}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("create notification: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if gotRequest.Title != "解析学 休講のお知らせ" || gotRequest.Body != "4/1 1限 の 解析学 は休講です" || gotRequest.Url != "https://example.com/subjects/s1" {
+		t.Fatalf("upstream request = %+v", gotRequest)
+	}
+	if len(gotRequest.TargetUserIds) != 2 {
+		t.Fatalf("targetUserIds = %v", gotRequest.TargetUserIds)
+	}
+}
@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/fun-dotto/admin-bff-api/generated"
+	"github.com/fun-dotto/admin-bff-api/internal/etag"
+	"github.com/gin-gonic/gin"
+)
+
+func TestNotificationDeliveryStatus(t *testing.T) {
+	after := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	before := time.Date(2026, 4, 1, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		delivered int
+		pending   int
+		now       time.Time
+		want      api.NotificationDeliveryStatus
+	}{
+		{name: "no targets", now: after, want: api.NotificationDeliveryStatusDraft},
+		{name: "before window", pending: 2, now: after.Add(-time.Hour), want: api.NotificationDeliveryStatusScheduled},
+		{name: "in window", delivered: 1, pending: 1, now: after.Add(time.Hour), want: api.NotificationDeliveryStatusSending},
+		{name: "dispatched early", delivered: 1, pending: 1, now: after.Add(-time.Hour), want: api.NotificationDeliveryStatusSending},
+		{name: "all delivered", delivered: 2, now: after.Add(time.Hour), want: api.NotificationDeliveryStatusDelivered},
+		{name: "window passed with some delivered", delivered: 1, pending: 1, now: before.Add(time.Second), want: api.NotificationDeliveryStatusPartiallyDelivered},
+		{name: "window passed with none delivered", pending: 2, now: before.Add(time.Second), want: api.NotificationDeliveryStatusExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := notificationDeliveryStatus(tt.delivered, tt.pending, after, before, tt.now); got != tt.want {
+				t.Fatalf("notificationDeliveryStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotificationV1Lifecycle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"notifications":[
+			{"id":"expired","title":"Expired","body":"b","notifyAfter":"2000-01-01T00:00:00Z","notifyBefore":"2000-01-02T00:00:00Z",
+			 "targetUsers":[{"userId":"user-1","notifiedAt":"2000-01-01T00:00:00Z"},{"userId":"user-2"}]},
+			{"id":"scheduled","title":"Scheduled","body":"b","notifyAfter":"2999-01-01T00:00:00Z","notifyBefore":"2999-01-02T00:00:00Z",
+			 "targetUsers":[{"userId":"user-1"}]}
+		]}`))
+	}))
+	defer server.Close()
+
+	h := newTestHandler(t, server.URL)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v1/notifications/lifecycle", nil)
+
+	statuses := []api.NotificationDeliveryStatus{api.NotificationDeliveryStatusPartiallyDelivered}
+	h.NotificationV1Lifecycle(c, api.NotificationV1LifecycleParams{Statuses: &statuses})
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	var body struct {
+		Notifications []api.NotificationLifecycle `json:"notifications"`
+		StatusCounts  map[string]int              `json:"statusCounts"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	if len(body.Notifications) != 1 {
+		t.Fatalf("unexpected response body: %s", rec.Body.String())
+	}
+	got := body.Notifications[0]
+	if got.Id != "expired" || got.DeliveredCount != 1 || got.PendingCount != 1 || len(got.UndeliveredUserIds) != 1 || got.UndeliveredUserIds[0] != "user-2" {
+		t.Fatalf("notifications[0] = %+v", got)
+	}
+	if body.StatusCounts["partiallyDelivered"] != 1 || body.StatusCounts["scheduled"] != 1 {
+		t.Fatalf("statusCounts = %v", body.StatusCounts)
+	}
+}
+
+func TestNotificationV1DispatchDryRun(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	dispatched := false
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/notifications", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"notifications":[
+			{"id":"n1","title":"t","body":"b","notifyAfter":"2026-04-01T00:00:00Z","notifyBefore":"2026-04-02T00:00:00Z","targetUsers":[{"userId":"u1"},{"userId":"u2"}]},
+			{"id":"n2","title":"t","body":"b","notifyAfter":"2026-04-01T00:00:00Z","notifyBefore":"2026-04-02T00:00:00Z","targetUsers":[{"userId":"u2"},{"userId":"u3"}]},
+			{"id":"n3","title":"t","body":"b","notifyAfter":"2026-04-01T00:00:00Z","notifyBefore":"2026-04-02T00:00:00Z","targetUsers":[{"userId":"u4"}]}
+		]}`))
+	})
+	mux.HandleFunc("GET /v1/fcmTokens", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("userIds"); got != "u1,u2,u3" {
+			t.Errorf("userIds = %q", got)
+		}
+		_, _ = w.Write([]byte(`{"fcmTokens":[
+			{"token":"a","userId":"u1","createdAt":"2026-04-01T00:00:00Z","updatedAt":"2026-04-01T00:00:00Z"},
+			{"token":"b","userId":"u1","createdAt":"2026-04-01T00:00:00Z","updatedAt":"2026-04-01T00:00:00Z"},
+			{"token":"c","userId":"u3","createdAt":"2026-04-01T00:00:00Z","updatedAt":"2026-04-01T00:00:00Z"}
+		]}`))
+	})
+	mux.HandleFunc("POST /v1/notifications/dispatch", func(w http.ResponseWriter, r *http.Request) {
+		dispatched = true
+		_, _ = w.Write([]byte(`{"notifications":[]}`))
+	})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mux.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	h := newTestHandler(t, server.URL)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/notifications/dispatch?dryRun=true", strings.NewReader(`{"notificationIds":["n1","n2","missing","n1"]}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	dryRun := true
+	h.NotificationV1Dispatch(c, api.NotificationV1DispatchParams{DryRun: &dryRun})
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if dispatched {
+		t.Fatal("dry run must not dispatch notifications")
+	}
+	var body struct {
+		Notifications []struct {
+			ID string `json:"id"`
+		} `json:"notifications"`
+		TargetUserIDs          []string `json:"targetUserIds"`
+		ReachableUserCount     int      `json:"reachableUserCount"`
+		MissingNotificationIDs []string `json:"missingNotificationIds"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	if len(body.Notifications) != 2 || body.Notifications[0].ID != "n1" || body.Notifications[1].ID != "n2" {
+		t.Fatalf("notifications = %+v", body.Notifications)
+	}
+	if !slices.Equal(body.TargetUserIDs, []string{"u1", "u2", "u3"}) || body.ReachableUserCount != 2 {
+		t.Fatalf("targetUserIds = %v, reachableUserCount = %d", body.TargetUserIDs, body.ReachableUserCount)
+	}
+	if !slices.Equal(body.MissingNotificationIDs, []string{"missing"}) {
+		t.Fatalf("missingNotificationIds = %v", body.MissingNotificationIDs)
+	}
+}
+
+func TestNotificationV1Detail_IfMatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const notification = `{"id":"n1","title":"t","body":"b","notifyAfter":"2026-04-01T00:00:00Z","notifyBefore":"2026-04-02T00:00:00Z","targetUsers":[{"userId":"u1"}]}`
+	updates := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/notifications", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"notifications":[` + notification + `]}`))
+	})
+	mux.HandleFunc("PUT /v1/notifications/{id}", func(w http.ResponseWriter, r *http.Request) {
+		updates++
+		_, _ = w.Write([]byte(`{"notification":` + notification + `}`))
+	})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mux.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	h := newTestHandler(t, server.URL)
+	detail := func(id string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(rec)
+		c.Request = httptest.NewRequest(http.MethodGet, "/v1/notifications/"+id, nil)
+		h.NotificationV1Detail(c, id)
+		return rec
+	}
+
+	if rec := detail("missing"); rec.Code != http.StatusNotFound {
+		t.Fatalf("missing: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	rec := detail("n1")
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"id":"n1"`) {
+		t.Fatalf("detail: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+
+	// 詳細取得のレスポンスの ETag で条件付き更新できる
+	current := etag.Compute(rec.Body.Bytes())
+	update := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(update)
+	c.Request = httptest.NewRequest(http.MethodPut, "/v1/notifications/n1", strings.NewReader(`{"title":"t","body":"b","notifyAfter":"2026-04-01T00:00:00Z","notifyBefore":"2026-04-02T00:00:00Z","targetUserIds":["u1"]}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	h.NotificationV1Update(c, "n1", api.NotificationV1UpdateParams{IfMatch: &current})
+	if update.Code != http.StatusOK || updates != 1 {
+		t.Fatalf("update: status = %d, updates = %d, body = %s", update.Code, updates, update.Body.String())
+	}
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/generated/external/user_api"
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+)
+
+// errorCodeInvalidNotification 通知の内容が上流に転送できない値の場合のエラーコード
+const errorCodeInvalidNotification = "INVALID_NOTIFICATION"
+
+var (
+	// androidPriorities FCM が受け付ける Android の通知優先度
+	androidPriorities = []string{"normal", "high"}
+	// apnsSoundExtensions APNs がカスタムサウンドとして再生できるファイルの拡張子
+	apnsSoundExtensions = []string{".caf", ".aiff", ".wav"}
+)
+
+// validateNotificationRequest 上流 API が検証せずに FCM へ渡す項目を確認し、不正な項目の一覧を返す
+// field は JSON Pointer 形式で、prefix は通知がリクエストボディの中に入れ子になっている場合の位置
+func validateNotificationRequest(req user_api.NotificationRequest, prefix string) []problem.FieldError {
+	var errs []problem.FieldError
+	invalid := func(field, message string) {
+		errs = append(errs, problem.FieldError{Field: prefix + "/" + field, In: "body", Message: message})
+	}
+
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{
+		{"imageUrl", req.ImageUrl},
+		{"url", req.Url},
+		{"webpushLink", req.WebpushLink},
+	} {
+		if field.value != nil && !isAbsoluteHTTPSURL(*field.value) {
+			invalid(field.name, "must be an absolute https URL")
+		}
+	}
+	if req.ApnsBadge != nil && *req.ApnsBadge < 0 {
+		invalid("apnsBadge", "must be greater than or equal to 0")
+	}
+	if req.ApnsSound != nil && !isValidAPNsSound(*req.ApnsSound) {
+		invalid("apnsSound", `must be "default" or a file name ending in `+strings.Join(apnsSoundExtensions, ", "))
+	}
+	if req.AndroidPriority != nil && !slices.Contains(androidPriorities, *req.AndroidPriority) {
+		invalid("androidPriority", "must be one of "+strings.Join(androidPriorities, ", "))
+	}
+	if req.AndroidTtlSeconds != nil && *req.AndroidTtlSeconds < 0 {
+		invalid("androidTtlSeconds", "must be greater than or equal to 0")
+	}
+	if !req.NotifyAfter.Before(req.NotifyBefore) {
+		invalid("notifyAfter", "must be before notifyBefore")
+	}
+	return errs
+}
+
+// isAbsoluteHTTPSURL 通知から開く URL として使える https の絶対 URL かを判定する
+func isAbsoluteHTTPSURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && u.Scheme == "https" && u.Host != ""
+}
+
+// isValidAPNsSound APNs の通知音として指定できる値かを判定する
+// 空文字は無音、default は OS 標準の通知音を表す
+func isValidAPNsSound(sound string) bool {
+	if sound == "" || sound == "default" {
+		return true
+	}
+	ext := strings.ToLower(path.Ext(sound))
+	return len(sound) > len(ext) && slices.Contains(apnsSoundExtensions, ext)
+}
+
+// respondInvalidNotification 通知の内容の検証に失敗した場合のレスポンスを書き込む
+func respondInvalidNotification(c *gin.Context, errs []problem.FieldError) {
+	problem.Respond(c, invalidNotificationProblem(errs))
+}
+
+// invalidNotificationProblem 通知の内容の検証に失敗したことを表す Problem を返す
+func invalidNotificationProblem(errs []problem.FieldError) *problem.Problem {
+	return problem.New(http.StatusBadRequest, "notification has invalid fields").
+		WithCode(errorCodeInvalidNotification).
+		WithErrors(errs)
+}
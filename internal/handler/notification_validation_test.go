@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/generated/external/user_api"
+)
+
+func TestValidateNotificationRequest(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+	intPtr := func(i int) *int { return &i }
+	notifyAfter := time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		modify func(req *user_api.NotificationRequest)
+		want   []string
+	}{
+		{name: "valid", modify: func(req *user_api.NotificationRequest) {
+			req.ImageUrl = ptr("https://example.com/image.png")
+			req.ApnsSound = ptr("alert.caf")
+			req.AndroidPriority = ptr("high")
+			req.ApnsBadge = intPtr(0)
+		}},
+		{name: "default sound", modify: func(req *user_api.NotificationRequest) { req.ApnsSound = ptr("default") }},
+		{name: "silent", modify: func(req *user_api.NotificationRequest) { req.ApnsSound = ptr("") }},
+		{name: "android priority is case sensitive", modify: func(req *user_api.NotificationRequest) { req.AndroidPriority = ptr("High") }, want: []string{"/androidPriority"}},
+		{name: "unsupported sound", modify: func(req *user_api.NotificationRequest) { req.ApnsSound = ptr("alert.mp3") }, want: []string{"/apnsSound"}},
+		{name: "negative counts", modify: func(req *user_api.NotificationRequest) {
+			req.ApnsBadge = intPtr(-1)
+			req.AndroidTtlSeconds = intPtr(-1)
+		}, want: []string{"/apnsBadge", "/androidTtlSeconds"}},
+		{name: "urls", modify: func(req *user_api.NotificationRequest) {
+			req.ImageUrl = ptr("http://example.com/image.png")
+			req.Url = ptr("/announcements/1")
+			req.WebpushLink = ptr("https://")
+		}, want: []string{"/imageUrl", "/url", "/webpushLink"}},
+		{name: "notify window", modify: func(req *user_api.NotificationRequest) { req.NotifyBefore = req.NotifyAfter }, want: []string{"/notifyAfter"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := user_api.NotificationRequest{Title: "t", Body: "b", NotifyAfter: notifyAfter, NotifyBefore: notifyAfter.Add(time.Hour)}
+			tt.modify(&req)
+
+			var got []string
+			for _, e := range validateNotificationRequest(req, "") {
+				got = append(got, e.Field)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("fields = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotificationV1CreateForAudienceValidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var created []string
+	h := newTestHandler(t, newAudienceUpstream(t, &created).URL)
+
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/notifications/audience", strings.NewReader(`{
+		"audience":{"grades":["B1"]},
+		"notification":{"title":"t","body":"b","androidPriority":"High","notifyAfter":"2026-04-01T00:00:00Z","notifyBefore":"2026-04-02T00:00:00Z"}
+	}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	h.NotificationV1CreateForAudience(c)
+
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), `"field":"/notification/androidPriority"`) {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if created != nil {
+		t.Fatalf("notification created for %v", created)
+	}
+}
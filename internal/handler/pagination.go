@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	api "github.com/fun-dotto/admin-bff-api/generated"
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+)
+
+// errorCodeInvalidCursor cursor を解釈できなかった場合のエラーコード
+const errorCodeInvalidCursor = "INVALID_CURSOR"
+
+// errInvalidCursor cursor が BFF の発行したものではない場合のエラー
+var errInvalidCursor = errors.New("invalid cursor")
+
+// page 一覧をページングした結果
+type page[T any] struct {
+	Items      []T
+	TotalCount int
+	NextCursor *string
+}
+
+// paginate 上流 API から取得した一覧を sort の順に並べ替え、cursor の位置から limit 件を切り出す
+// 上流 API はページングに対応していないため、全件を取得した上で BFF 側で切り出す
+// cursor は先頭からの件数を表すため、ページを跨ぐ間に上流のデータが変わると重複や欠落が起こりうる
+func paginate[T any](items []T, limit *api.PageLimit, cursor *api.PageCursor, sort *string) (page[T], error) {
+	offset := 0
+	if cursor != nil {
+		var err error
+		if offset, err = decodeCursor(*cursor); err != nil {
+			return page[T]{}, err
+		}
+	}
+
+	if sort != nil && *sort != "" {
+		items = sortItems(items, *sort)
+	}
+
+	total := len(items)
+	start := min(offset, total)
+	end := total
+	if limit != nil {
+		end = min(start+*limit, total)
+	}
+
+	p := page[T]{Items: items[start:end], TotalCount: total}
+	if p.Items == nil {
+		p.Items = []T{}
+	}
+	if end < total {
+		next := encodeCursor(end)
+		p.NextCursor = &next
+	}
+	return p, nil
+}
+
+// body 一覧を key に格納したレスポンスボディを返す
+func (p page[T]) body(key string) gin.H {
+	body := gin.H{key: p.Items, "totalCount": p.TotalCount}
+	if p.NextCursor != nil {
+		body["nextCursor"] = *p.NextCursor
+	}
+	return body
+}
+
+// respondInvalidPageParams ページングのパラメータが不正な場合のレスポンスを書き込む
+func respondInvalidPageParams(c *gin.Context, err error) {
+	problem.Respond(c, problem.New(http.StatusBadRequest, err.Error()).WithCode(errorCodeInvalidCursor))
+}
+
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errInvalidCursor
+	}
+	offset, err := strconv.Atoi(string(raw))
+	if err != nil || offset < 0 {
+		return 0, errInvalidCursor
+	}
+	return offset, nil
+}
+
+// sortKey 並び替えに使うフィールドと方向
+type sortKey struct {
+	field      string
+	descending bool
+}
+
+// sortItems sort の指定に従って一覧を安定ソートする
+// 並び替えるフィールドは JSON のフィールド名で指定し、値を持たない要素は方向に関わらず末尾に並べる
+func sortItems[T any](items []T, sort string) []T {
+	var keys []sortKey
+	for field := range strings.SplitSeq(sort, ",") {
+		key := sortKey{field: strings.TrimSpace(field)}
+		if name, ok := strings.CutPrefix(key.field, "-"); ok {
+			key.field, key.descending = name, true
+		}
+		keys = append(keys, key)
+	}
+
+	type row struct {
+		item   T
+		values map[string]any
+	}
+	rows := make([]row, len(items))
+	for i, item := range items {
+		// 生成された型は常に JSON オブジェクトに変換できるため、エラーは値を持たない要素として扱う
+		var values map[string]any
+		if raw, err := json.Marshal(item); err == nil {
+			_ = json.Unmarshal(raw, &values)
+		}
+		rows[i] = row{item: item, values: values}
+	}
+
+	slices.SortStableFunc(rows, func(a, b row) int {
+		for _, key := range keys {
+			av, bv := a.values[key.field], b.values[key.field]
+			switch {
+			case av == nil && bv == nil:
+				continue
+			case av == nil:
+				return 1
+			case bv == nil:
+				return -1
+			}
+			result := compareValues(av, bv)
+			if key.descending {
+				result = -result
+			}
+			if result != 0 {
+				return result
+			}
+		}
+		return 0
+	})
+
+	sorted := make([]T, len(rows))
+	for i, r := range rows {
+		sorted[i] = r.item
+	}
+	return sorted
+}
+
+// compareValues JSON の値を比較する
+// 日時の文字列は時刻として比較し、型が異なる値は文字列表現で比較する
+func compareValues(a, b any) int {
+	switch av := a.(type) {
+	case float64:
+		if bv, ok := b.(float64); ok {
+			return cmp.Compare(av, bv)
+		}
+	case bool:
+		if bv, ok := b.(bool); ok {
+			switch {
+			case av == bv:
+				return 0
+			case !av:
+				return -1
+			default:
+				return 1
+			}
+		}
+	case string:
+		if bv, ok := b.(string); ok {
+			at, aerr := time.Parse(time.RFC3339, av)
+			bt, berr := time.Parse(time.RFC3339, bv)
+			if aerr == nil && berr == nil {
+				return at.Compare(bt)
+			}
+			return strings.Compare(av, bv)
+		}
+	}
+	aj, _ := json.Marshal(a)
+	bj, _ := json.Marshal(b)
+	return strings.Compare(string(aj), string(bj))
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/fun-dotto/admin-bff-api/generated"
+	"github.com/gin-gonic/gin"
+)
+
+func TestPaginate(t *testing.T) {
+	type item struct {
+		ID    string  `json:"id"`
+		Year  int     `json:"year"`
+		Email *string `json:"email,omitempty"`
+	}
+	email := "a@example.com"
+	items := []item{{ID: "c", Year: 2024}, {ID: "a", Year: 2025, Email: &email}, {ID: "b", Year: 2024}}
+
+	limit := 2
+	sort := "year,-id"
+	first, err := paginate(items, &limit, nil, &sort)
+	if err != nil {
+		t.Fatalf("first page: %v", err)
+	}
+	if first.TotalCount != 3 || len(first.Items) != 2 || first.Items[0].ID != "c" || first.Items[1].ID != "b" || first.NextCursor == nil {
+		t.Fatalf("first page = %+v", first)
+	}
+
+	second, err := paginate(items, &limit, first.NextCursor, &sort)
+	if err != nil {
+		t.Fatalf("second page: %v", err)
+	}
+	if len(second.Items) != 1 || second.Items[0].ID != "a" || second.NextCursor != nil {
+		t.Fatalf("second page = %+v", second)
+	}
+
+	// 値を持たない要素は降順でも末尾に並べる
+	sort = "-email"
+	all, err := paginate(items, nil, nil, &sort)
+	if err != nil {
+		t.Fatalf("sort by email: %v", err)
+	}
+	if all.Items[0].ID != "a" || all.Items[1].ID != "c" || all.Items[2].ID != "b" {
+		t.Fatalf("sort by email = %+v", all.Items)
+	}
+
+	invalid := "not-a-cursor"
+	if _, err := paginate(items, nil, &invalid, nil); !errors.Is(err, errInvalidCursor) {
+		t.Fatalf("invalid cursor error = %v", err)
+	}
+}
+
+func TestUsersV1List_Paginates(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"users":[
+			{"id":"user-2","email":"b@example.com"},
+			{"id":"user-1","email":"a@example.com"},
+			{"id":"user-3","email":"c@example.com"}
+		]}`))
+	}))
+	defer server.Close()
+
+	h := newTestHandler(t, server.URL)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v1/users?limit=2&sort=email", nil)
+
+	limit := 2
+	sort := "email"
+	h.UsersV1List(c, api.UsersV1ListParams{Limit: &limit, Sort: &sort})
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	var body struct {
+		Users []struct {
+			ID string `json:"id"`
+		} `json:"users"`
+		TotalCount int     `json:"totalCount"`
+		NextCursor *string `json:"nextCursor"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	if body.TotalCount != 3 || len(body.Users) != 2 || body.Users[0].ID != "user-1" || body.Users[1].ID != "user-2" || body.NextCursor == nil {
+		t.Fatalf("unexpected response body: %s", rec.Body.String())
+	}
+}
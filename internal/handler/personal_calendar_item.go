@@ -7,28 +7,29 @@ import (
 
 	api "github.com/fun-dotto/admin-bff-api/generated"
 	"github.com/fun-dotto/admin-bff-api/generated/external/academic_api"
-	"github.com/fun-dotto/admin-bff-api/internal/middleware"
 )
 
 // PersonalCalendarItemsV1List 個人カレンダーアイテム一覧を取得する
 func (h *Handler) PersonalCalendarItemsV1List(c *gin.Context, params api.PersonalCalendarItemsV1ListParams) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	response, err := h.academicClient.PersonalCalendarItemsV1ListWithResponse(c.Request.Context(), &academic_api.PersonalCalendarItemsV1ListParams{
 		UserId: params.UserId,
 		Dates:  params.Dates,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
+		return
+	}
+
+	page, err := paginate(response.JSON200.PersonalCalendarItems, params.Limit, params.Cursor, params.Sort)
+	if err != nil {
+		respondInvalidPageParams(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, response.JSON200)
+	c.JSON(http.StatusOK, page.body("personalCalendarItems"))
 }
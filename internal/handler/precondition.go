@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/internal/etag"
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+)
+
+// errorCodePreconditionFailed If-Match が現在のリソースの ETag と一致しなかった場合のエラーコード
+const errorCodePreconditionFailed = "PRECONDITION_FAILED"
+
+// checkIfMatch 現在のリソースの表現から ETag を計算し、If-Match と一致するかを確認する
+// current には詳細取得と同じ形式のレスポンスボディを渡す
+// 時刻から導出した項目を含むリソースでは、導出しうる全ての表現を渡し、いずれかと一致すれば編集されていないものとして扱う
+// 一致しない場合は 412 を書き込んで false を返す
+// 確認と上流の更新は不可分ではないため、同時に送られた 2 つの PUT がどちらも確認を通過することはありうる
+func checkIfMatch(c *gin.Context, ifMatch string, current ...any) bool {
+	for _, representation := range current {
+		body, err := json.Marshal(representation)
+		if err != nil {
+			problem.Respond(c, problem.New(http.StatusInternalServerError, "failed to compute ETag"))
+			return false
+		}
+		if etag.MatchesIfMatch(ifMatch, etag.Compute(body)) {
+			return true
+		}
+	}
+	problem.Respond(c, problem.New(http.StatusPreconditionFailed, "resource has been modified").WithCode(errorCodePreconditionFailed))
+	return false
+}
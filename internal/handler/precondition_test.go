@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/fun-dotto/admin-bff-api/generated"
+	"github.com/fun-dotto/admin-bff-api/internal/etag"
+	"github.com/fun-dotto/admin-bff-api/internal/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+func TestRoomsV1Update_IfMatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const room = `{"room":{"id":"room-1","name":"363","floor":"Floor3"}}`
+	updates := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut {
+			updates++
+		}
+		_, _ = w.Write([]byte(room))
+	}))
+	defer server.Close()
+
+	h := newTestHandler(t, server.URL)
+	update := func(ifMatch string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(rec)
+		c.Request = httptest.NewRequest(http.MethodPut, "/v1/rooms/room-1", strings.NewReader(`{"name":"363","floor":"Floor3"}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		h.RoomsV1Update(c, "room-1", api.RoomsV1UpdateParams{IfMatch: &ifMatch})
+		return rec
+	}
+
+	// 詳細取得と同じレスポンスを返すため、詳細取得の ETag で更新できる
+	detail := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(detail)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v1/rooms/room-1", nil)
+	h.RoomsV1Detail(c, "room-1")
+	current := etag.Compute(detail.Body.Bytes())
+
+	if rec := update(`"stale"`); rec.Code != http.StatusPreconditionFailed || !strings.Contains(rec.Body.String(), errorCodePreconditionFailed) {
+		t.Fatalf("stale If-Match: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if updates != 0 {
+		t.Fatal("upstream must not be updated when the precondition fails")
+	}
+	if rec := update(current); rec.Code != http.StatusOK {
+		t.Fatalf("current If-Match: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if updates != 1 {
+		t.Fatalf("updates = %d, want 1", updates)
+	}
+}
+
+func TestAnnouncementsV1Update_IfMatchIgnoresStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// 詳細取得の後に公開開始となり、status が scheduled から active に変わるおしらせ
+	availableFrom := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	announcement := `{"announcement":{"id":"a1","title":"t","availableFrom":"` + availableFrom.Format(time.RFC3339) + `","url":"https://example.com/a1"}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(announcement))
+	}))
+	defer server.Close()
+
+	h := newTestHandler(t, server.URL)
+	now := availableFrom.Add(-time.Hour)
+	h.now = func() time.Time { return now }
+
+	router := gin.New()
+	router.Use(middleware.ETag())
+	router.GET("/v1/announcements/:id", func(c *gin.Context) { h.AnnouncementsV1Detail(c, c.Param("id")) })
+	router.PUT("/v1/announcements/:id", func(c *gin.Context) {
+		ifMatch := c.GetHeader("If-Match")
+		h.AnnouncementsV1Update(c, c.Param("id"), api.AnnouncementsV1UpdateParams{IfMatch: &ifMatch})
+	})
+	serve := func(method, ifNoneMatch, ifMatch, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/v1/announcements/a1", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	detail := serve(http.MethodGet, "", "", "")
+	scheduled := detail.Header().Get("ETag")
+	if scheduled == "" || !strings.Contains(detail.Body.String(), `"status":"scheduled"`) {
+		t.Fatalf("detail: ETag = %q, body = %s", scheduled, detail.Body.String())
+	}
+
+	now = availableFrom.Add(time.Hour)
+
+	// status が変わったレスポンスボディに対して 304 を返してはならない
+	revalidated := serve(http.MethodGet, scheduled, "", "")
+	if revalidated.Code != http.StatusOK || !strings.Contains(revalidated.Body.String(), `"status":"active"`) {
+		t.Fatalf("revalidate: status = %d, body = %s", revalidated.Code, revalidated.Body.String())
+	}
+	if got := revalidated.Header().Get("ETag"); got == scheduled {
+		t.Fatalf("revalidate: ETag must change with the body, got %q", got)
+	}
+
+	// status の変化だけでは編集されたとみなさないため、公開前に取得した ETag で更新できる
+	body := `{"title":"t","availableFrom":"` + availableFrom.Format(time.RFC3339) + `","url":"https://example.com/a1"}`
+	if rec := serve(http.MethodPut, "", scheduled, body); rec.Code != http.StatusOK {
+		t.Fatalf("update: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	if rec := serve(http.MethodPut, "", `"stale"`, body); rec.Code != http.StatusPreconditionFailed {
+		t.Fatalf("stale update: status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+}
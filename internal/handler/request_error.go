@@ -0,0 +1,17 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+)
+
+// errorCodeInvalidRequestBody リクエストボディを解釈できなかった場合のエラーコード
+const errorCodeInvalidRequestBody = "INVALID_REQUEST_BODY"
+
+// respondInvalidRequestBody リクエストボディのバインドに失敗した場合のレスポンスを書き込む
+func respondInvalidRequestBody(c *gin.Context, err error) {
+	problem.Respond(c, problem.New(http.StatusBadRequest, err.Error()).WithCode(errorCodeInvalidRequestBody))
+}
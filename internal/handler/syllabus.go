@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SyllabusV1Detail シラバスを取得する
+func (h *Handler) SyllabusV1Detail(c *gin.Context, id string) {
+	response, err := h.academicClient.SyllabusV1DetailWithResponse(c.Request.Context(), id)
+	if err != nil {
+		respondUpstreamTransportError(c, err)
+		return
+	}
+
+	if response.JSON200 == nil {
+		respondUpstreamError(c, response.StatusCode(), response.Body)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.JSON200)
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestSyllabusV1Detail_ProxiesAcademicAPI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"syllabus":{"id":"syllabus-1","name":"Algorithms","summary":"Sorting and searching"}}`))
+	}))
+	defer server.Close()
+
+	h := newTestHandler(t, server.URL)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v1/subjects/subject-1/syllabus", nil)
+	setAdminClaim(c)
+
+	h.SyllabusV1Detail(c, "subject-1")
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if gotPath != "/v1/subjects/subject-1/syllabus" {
+		t.Fatalf("path = %q, want %q", gotPath, "/v1/subjects/subject-1/syllabus")
+	}
+
+	var body struct {
+		Syllabus struct {
+			Id      string `json:"id"`
+			Summary string `json:"summary"`
+		} `json:"syllabus"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	if body.Syllabus.Id != "syllabus-1" || body.Syllabus.Summary != "Sorting and searching" {
+		t.Fatalf("unexpected response body: %s", rec.Body.String())
+	}
+}
+
+func TestSyllabusV1Detail_PropagatesNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	h := newTestHandler(t, server.URL)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v1/subjects/missing/syllabus", nil)
+	setAdminClaim(c)
+
+	h.SyllabusV1Detail(c, "missing")
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
@@ -8,10 +8,18 @@ import (
 	"github.com/fun-dotto/admin-bff-api/generated/external/announcement_api"
 	"github.com/fun-dotto/admin-bff-api/generated/external/funch_api"
 	"github.com/fun-dotto/admin-bff-api/generated/external/user_api"
+	"github.com/fun-dotto/admin-bff-api/internal/audit"
+	"github.com/fun-dotto/admin-bff-api/internal/cache"
 	"github.com/fun-dotto/admin-bff-api/internal/middleware"
+	"github.com/fun-dotto/admin-bff-api/internal/notificationtemplate"
 	"github.com/gin-gonic/gin"
 )
 
+// testRolePolicy ハンドラーの中で認可する operation のロール
+var testRolePolicy = middleware.NewRolePolicy(map[string][]string{
+	"NotificationV1_create": {"admin", "developer"},
+})
+
 func newTestHandler(t *testing.T, baseURL string) *Handler {
 	t.Helper()
 
@@ -32,7 +40,7 @@ func newTestHandler(t *testing.T, baseURL string) *Handler {
 		t.Fatalf("new user client: %v", err)
 	}
 
-	return NewHandler(academicClient, announcementClient, funchClient, userClient)
+	return NewHandler(academicClient, announcementClient, funchClient, userClient, audit.NewMemorySink(100), cache.New(nil), notificationtemplate.NewMemoryStore(), testRolePolicy)
 }
 
 func setAdminClaim(c *gin.Context) {
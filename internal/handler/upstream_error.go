@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+	"github.com/fun-dotto/admin-bff-api/internal/resilience"
+)
+
+// 上流 API のエラーをクライアントに返す際の機械判読可能なエラーコード
+const (
+	errorCodeUpstreamBadRequest      = "UPSTREAM_BAD_REQUEST"
+	errorCodeUpstreamAuthFailed      = "UPSTREAM_AUTH_FAILED"
+	errorCodeUpstreamNotFound        = "UPSTREAM_NOT_FOUND"
+	errorCodeUpstreamConflict        = "UPSTREAM_CONFLICT"
+	errorCodeUpstreamUnprocessable   = "UPSTREAM_UNPROCESSABLE_ENTITY"
+	errorCodeUpstreamRateLimited     = "UPSTREAM_RATE_LIMITED"
+	errorCodeUpstreamClientError     = "UPSTREAM_CLIENT_ERROR"
+	errorCodeUpstreamServerError     = "UPSTREAM_SERVER_ERROR"
+	errorCodeUpstreamUnavailable     = "UPSTREAM_UNAVAILABLE"
+	errorCodeUpstreamInvalidResponse = "UPSTREAM_INVALID_RESPONSE"
+	errorCodeUpstreamTimeout         = "UPSTREAM_TIMEOUT"
+	errorCodeUpstreamUnreachable     = "UPSTREAM_UNREACHABLE"
+	errorCodeUpstreamRequestFailed   = "UPSTREAM_REQUEST_FAILED"
+	errorCodeUpstreamCircuitOpen     = "UPSTREAM_CIRCUIT_OPEN"
+)
+
+// maxUpstreamMessageLength 上流のレスポンスボディをメッセージとして転送する際の最大長
+const maxUpstreamMessageLength = 1024
+
+// upstreamError 上流 API 呼び出しの失敗をクライアント向けに変換した結果
+type upstreamError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	// RetryAfter が 0 より大きい場合は Retry-After ヘッダーを付与する
+	RetryAfter time.Duration
+}
+
+// upstreamResponseError 複数の上流 API 呼び出しをまとめた処理で、上流 API が期待外のステータスを返したことを表すエラー
+type upstreamResponseError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (e *upstreamResponseError) Error() string {
+	return fmt.Sprintf("unexpected upstream response: %d", e.StatusCode)
+}
+
+// respondUpstreamFailure 上流 API 呼び出しをまとめた処理が返したエラーのレスポンスを書き込む
+func respondUpstreamFailure(c *gin.Context, err error) {
+	writeUpstreamError(c, translateUpstreamFailure(err))
+}
+
+// translateUpstreamFailure 上流 API 呼び出しをまとめた処理が返したエラーをクライアント向けのエラーに変換する
+func translateUpstreamFailure(err error) upstreamError {
+	var responseErr *upstreamResponseError
+	if errors.As(err, &responseErr) {
+		return translateUpstreamResponse(responseErr.StatusCode, responseErr.Body)
+	}
+	return translateUpstreamTransportError(err)
+}
+
+// respondUpstreamError 上流 API が期待外のステータスを返した場合のレスポンスを書き込む
+func respondUpstreamError(c *gin.Context, statusCode int, body []byte) {
+	writeUpstreamError(c, translateUpstreamResponse(statusCode, body))
+}
+
+// respondUpstreamTransportError 上流 API へのリクエスト自体が失敗した場合のレスポンスを書き込む
+func respondUpstreamTransportError(c *gin.Context, err error) {
+	writeUpstreamError(c, translateUpstreamTransportError(err))
+}
+
+func writeUpstreamError(c *gin.Context, e upstreamError) {
+	if e.RetryAfter > 0 {
+		seconds := int(math.Ceil(e.RetryAfter.Seconds()))
+		c.Header("Retry-After", strconv.Itoa(seconds))
+	}
+	problem.Respond(c, problem.New(e.StatusCode, e.Message).WithCode(e.Code))
+}
+
+// translateUpstreamResponse 上流のステータスコードとレスポンスボディをクライアント向けのエラーに変換する
+// 上流の 401 / 403 は BFF のサービスアカウントが拒否されたことを意味するため、
+// クライアント自身の認証エラーと区別できるよう 502 として返す
+func translateUpstreamResponse(statusCode int, body []byte) upstreamError {
+	status := statusCode
+	var code string
+	switch {
+	case statusCode == http.StatusBadRequest:
+		code = errorCodeUpstreamBadRequest
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		status = http.StatusBadGateway
+		code = errorCodeUpstreamAuthFailed
+	case statusCode == http.StatusNotFound:
+		code = errorCodeUpstreamNotFound
+	case statusCode == http.StatusConflict:
+		code = errorCodeUpstreamConflict
+	case statusCode == http.StatusUnprocessableEntity:
+		code = errorCodeUpstreamUnprocessable
+	case statusCode == http.StatusTooManyRequests:
+		code = errorCodeUpstreamRateLimited
+	case statusCode == http.StatusServiceUnavailable:
+		code = errorCodeUpstreamUnavailable
+	case statusCode == http.StatusGatewayTimeout:
+		code = errorCodeUpstreamTimeout
+	case statusCode >= 400 && statusCode < 500:
+		code = errorCodeUpstreamClientError
+	case statusCode >= 500 && statusCode < 600:
+		code = errorCodeUpstreamServerError
+	default:
+		// 2xx でもスキーマに合わないレスポンスなど、BFF が解釈できない応答
+		status = http.StatusBadGateway
+		code = errorCodeUpstreamInvalidResponse
+	}
+
+	message := upstreamMessage(body)
+	if message == "" {
+		if code == errorCodeUpstreamInvalidResponse {
+			message = "unexpected response from upstream"
+		} else {
+			message = http.StatusText(statusCode)
+		}
+	}
+
+	return upstreamError{StatusCode: status, Code: code, Message: message}
+}
+
+// translateUpstreamTransportError 上流へのリクエストが送信・受信できなかった場合のエラーを変換する
+// 内部 URL などが含まれるため、元のエラーメッセージはクライアントに返さない
+func translateUpstreamTransportError(err error) upstreamError {
+	var openErr *resilience.CircuitOpenError
+	if errors.As(err, &openErr) {
+		return upstreamError{
+			StatusCode: http.StatusServiceUnavailable,
+			Code:       errorCodeUpstreamCircuitOpen,
+			Message:    "upstream is temporarily unavailable",
+			RetryAfter: openErr.RetryAfter,
+		}
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return upstreamError{
+			StatusCode: http.StatusGatewayTimeout,
+			Code:       errorCodeUpstreamTimeout,
+			Message:    "upstream request timed out",
+		}
+	}
+
+	var opErr *net.OpError
+	var dnsErr *net.DNSError
+	if errors.As(err, &opErr) || errors.As(err, &dnsErr) {
+		return upstreamError{
+			StatusCode: http.StatusBadGateway,
+			Code:       errorCodeUpstreamUnreachable,
+			Message:    "failed to connect to upstream",
+		}
+	}
+
+	return upstreamError{
+		StatusCode: http.StatusBadGateway,
+		Code:       errorCodeUpstreamRequestFailed,
+		Message:    "upstream request failed",
+	}
+}
+
+// upstreamMessage 上流のレスポンスボディからエラーメッセージを取り出す
+// JSON の場合は error / message / detail / title の順に文字列フィールドを探し、
+// それ以外の場合はテキストとしてそのまま使う
+func upstreamMessage(body []byte) string {
+	trimmed := strings.TrimSpace(string(body))
+	if trimmed == "" {
+		return ""
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(trimmed), &fields); err == nil {
+		for _, key := range []string{"error", "message", "detail", "title"} {
+			if message, ok := fields[key].(string); ok && message != "" {
+				return truncateMessage(message)
+			}
+		}
+		return ""
+	}
+
+	if !utf8.ValidString(trimmed) || strings.HasPrefix(trimmed, "<") {
+		return ""
+	}
+	return truncateMessage(trimmed)
+}
+
+func truncateMessage(message string) string {
+	if len(message) <= maxUpstreamMessageLength {
+		return message
+	}
+	cut := maxUpstreamMessageLength
+	for cut > 0 && !utf8.RuneStart(message[cut]) {
+		cut--
+	}
+	return message[:cut]
+}
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+	"github.com/fun-dotto/admin-bff-api/internal/resilience"
+)
+
+type upstreamErrorBody struct {
+	Detail string `json:"detail"`
+	Code   string `json:"code"`
+}
+
+func decodeUpstreamErrorBody(t *testing.T, rec *httptest.ResponseRecorder) upstreamErrorBody {
+	t.Helper()
+
+	var body upstreamErrorBody
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	return body
+}
+
+func TestRoomsV1Create_PropagatesUpstreamStatusAndMessage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"message":"room name already exists"}`))
+	}))
+	defer server.Close()
+
+	h := newTestHandler(t, server.URL)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/rooms", strings.NewReader(`{"name":"363","floor":"Floor3"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+	setAdminClaim(c)
+
+	h.RoomsV1Create(c)
+
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+	body := decodeUpstreamErrorBody(t, rec)
+	if got := rec.Header().Get("Content-Type"); !strings.HasPrefix(got, problem.ContentType) {
+		t.Fatalf("content type = %q, want %q", got, problem.ContentType)
+	}
+	if body.Detail != "room name already exists" || body.Code != errorCodeUpstreamConflict {
+		t.Fatalf("unexpected response body: %s", rec.Body.String())
+	}
+}
+
+func TestRoomsV1Detail_MapsTransportTimeoutToGatewayTimeout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	h := newTestHandler(t, server.URL)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	c.Request = httptest.NewRequest(http.MethodGet, "/v1/rooms/room-1", nil).WithContext(ctx)
+	setAdminClaim(c)
+
+	h.RoomsV1Detail(c, "room-1")
+
+	if rec.Code != http.StatusGatewayTimeout {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusGatewayTimeout)
+	}
+	if body := decodeUpstreamErrorBody(t, rec); body.Code != errorCodeUpstreamTimeout {
+		t.Fatalf("unexpected response body: %s", rec.Body.String())
+	}
+}
+
+func TestRoomsV1Detail_MapsConnectionFailureToBadGateway(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	baseURL := server.URL
+	server.Close()
+
+	h := newTestHandler(t, baseURL)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v1/rooms/room-1", nil)
+	setAdminClaim(c)
+
+	h.RoomsV1Detail(c, "room-1")
+
+	if rec.Code != http.StatusBadGateway {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadGateway)
+	}
+	body := decodeUpstreamErrorBody(t, rec)
+	if body.Code != errorCodeUpstreamUnreachable {
+		t.Fatalf("unexpected response body: %s", rec.Body.String())
+	}
+	if strings.Contains(body.Detail, baseURL) {
+		t.Fatalf("response leaks upstream URL: %s", rec.Body.String())
+	}
+}
+
+func TestRespondUpstreamTransportError_CircuitOpen(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v1/rooms", nil)
+
+	respondUpstreamTransportError(c, fmt.Errorf("Get \"http://academic/v1/rooms\": %w",
+		&resilience.CircuitOpenError{Service: "academic", RetryAfter: 1500 * time.Millisecond}))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "2" {
+		t.Fatalf("Retry-After = %q, want 2", got)
+	}
+	if body := decodeUpstreamErrorBody(t, rec); body.Code != errorCodeUpstreamCircuitOpen {
+		t.Fatalf("unexpected response body: %s", rec.Body.String())
+	}
+}
+
+func TestTranslateUpstreamResponse(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		body        string
+		wantStatus  int
+		wantCode    string
+		wantMessage string
+	}{
+		{name: "json error field", statusCode: http.StatusBadRequest, body: `{"error":"name is required"}`, wantStatus: http.StatusBadRequest, wantCode: errorCodeUpstreamBadRequest, wantMessage: "name is required"},
+		{name: "plain text", statusCode: http.StatusNotFound, body: "subject not found\n", wantStatus: http.StatusNotFound, wantCode: errorCodeUpstreamNotFound, wantMessage: "subject not found"},
+		{name: "empty body", statusCode: http.StatusServiceUnavailable, body: "", wantStatus: http.StatusServiceUnavailable, wantCode: errorCodeUpstreamUnavailable, wantMessage: "Service Unavailable"},
+		{name: "upstream auth failure", statusCode: http.StatusForbidden, body: "", wantStatus: http.StatusBadGateway, wantCode: errorCodeUpstreamAuthFailed, wantMessage: "Forbidden"},
+		{name: "unexpected success", statusCode: http.StatusOK, body: "", wantStatus: http.StatusBadGateway, wantCode: errorCodeUpstreamInvalidResponse, wantMessage: "unexpected response from upstream"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := translateUpstreamResponse(tt.statusCode, []byte(tt.body))
+			if got.StatusCode != tt.wantStatus || got.Code != tt.wantCode || got.Message != tt.wantMessage {
+				t.Fatalf("translateUpstreamResponse() = %+v", got)
+			}
+		})
+	}
+}
@@ -2,47 +2,101 @@ package handler
 
 import (
 	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 
+	api "github.com/fun-dotto/admin-bff-api/generated"
 	"github.com/fun-dotto/admin-bff-api/generated/external/user_api"
-	"github.com/fun-dotto/admin-bff-api/internal/middleware"
 )
 
 // UsersV1List ユーザー一覧を取得する
-func (h *Handler) UsersV1List(c *gin.Context) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
+func (h *Handler) UsersV1List(c *gin.Context, params api.UsersV1ListParams) {
 	response, err := h.userClient.UsersV1ListWithResponse(c.Request.Context())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
-	c.JSON(http.StatusOK, response.JSON200)
+	users, facets := filterUsers(response.JSON200.Users, params)
+	page, err := paginate(users, params.Limit, params.Cursor, params.Sort)
+	if err != nil {
+		respondInvalidPageParams(c, err)
+		return
+	}
+
+	body := page.body("users")
+	body["facets"] = facets
+	c.JSON(http.StatusOK, body)
 }
 
-// UsersV1Detail ユーザーを取得する
-func (h *Handler) UsersV1Detail(c *gin.Context, id string) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
+// filterUsers 絞り込み条件に一致するユーザーと、学年・コース・クラスごとの件数を返す
+// 上流 API が絞り込みに対応するまでは、全件を取得した上で BFF 側で絞り込む
+// 各項目の件数は、その項目自身の条件を除いた絞り込み結果から数える
+func filterUsers(users []user_api.User, params api.UsersV1ListParams) ([]user_api.User, api.UserFacets) {
+	facets := api.UserFacets{
+		Grades:  map[string]int{},
+		Courses: map[string]int{},
+		Classes: map[string]int{},
+	}
+	var email string
+	if params.Email != nil {
+		email = strings.ToLower(*params.Email)
 	}
 
+	matched := make([]user_api.User, 0, len(users))
+	for _, user := range users {
+		if !strings.Contains(strings.ToLower(user.Email), email) {
+			continue
+		}
+		grade := matchesAny(params.Grades, user.Grade)
+		course := matchesAny(params.Courses, user.Course)
+		class := matchesAny(params.Classes, user.Class)
+
+		if course && class && user.Grade != nil {
+			facets.Grades[string(*user.Grade)]++
+		}
+		if grade && class && user.Course != nil {
+			facets.Courses[string(*user.Course)]++
+		}
+		if grade && course && user.Class != nil {
+			facets.Classes[string(*user.Class)]++
+		}
+		if grade && course && class {
+			matched = append(matched, user)
+		}
+	}
+	return matched, facets
+}
+
+// matchesAny 値が指定された候補のいずれかと一致するかを判定する
+// 候補が指定されていない場合は常に一致し、値が未設定の場合は一致しない
+func matchesAny[S ~string, V ~string](selected *[]S, value *V) bool {
+	if selected == nil || len(*selected) == 0 {
+		return true
+	}
+	if value == nil {
+		return false
+	}
+	return slices.Contains(*selected, S(*value))
+}
+
+// UsersV1Detail ユーザーを取得する
+func (h *Handler) UsersV1Detail(c *gin.Context, id string) {
 	response, err := h.userClient.UsersV1DetailWithResponse(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
@@ -51,24 +105,20 @@ func (h *Handler) UsersV1Detail(c *gin.Context, id string) {
 
 // UsersV1Upsert ユーザーを作成または更新する
 func (h *Handler) UsersV1Upsert(c *gin.Context, id string) {
-	if !middleware.RequireAnyClaim(c, "admin", "developer") {
-		return
-	}
-
 	var req user_api.UserRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondInvalidRequestBody(c, err)
 		return
 	}
 
 	response, err := h.userClient.UsersV1UpsertWithResponse(c.Request.Context(), id, req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondUpstreamTransportError(c, err)
 		return
 	}
 
 	if response.JSON200 == nil {
-		c.JSON(response.StatusCode(), gin.H{"error": "unexpected response from upstream"})
+		respondUpstreamError(c, response.StatusCode(), response.Body)
 		return
 	}
 
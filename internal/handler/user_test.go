@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	api "github.com/fun-dotto/admin-bff-api/generated"
 	"github.com/gin-gonic/gin"
 )
 
@@ -47,3 +48,57 @@ func TestUsersV1Detail_ProxiesUserAPI(t *testing.T) {
 		t.Fatalf("unexpected response body: %s", rec.Body.String())
 	}
 }
+
+func TestUsersV1List_FiltersAndCountsFacets(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"users":[
+			{"id":"user-1","email":"Alice@fun.ac.jp","grade":"B2","course":"InformationDesign","class":"C"},
+			{"id":"user-2","email":"bob@fun.ac.jp","grade":"B2","course":"InformationDesign","class":"A"},
+			{"id":"user-3","email":"carol@fun.ac.jp","grade":"B3","course":"InformationDesign","class":"C"},
+			{"id":"user-4","email":"dave@example.com","grade":"B2","course":"InformationDesign","class":"C"},
+			{"id":"user-5","email":"eve@fun.ac.jp"}
+		]}`))
+	}))
+	defer server.Close()
+
+	h := newTestHandler(t, server.URL)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodGet, "/v1/users", nil)
+	setAdminClaim(c)
+
+	grades := []api.DottoFoundationV1Grade{api.B2}
+	courses := []api.DottoFoundationV1Course{api.InformationDesign}
+	classes := []api.DottoFoundationV1Class{api.C}
+	email := "@FUN.ac.jp"
+	h.UsersV1List(c, api.UsersV1ListParams{Grades: &grades, Courses: &courses, Classes: &classes, Email: &email})
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", rec.Code, rec.Body.String())
+	}
+	var body struct {
+		Users []struct {
+			ID string `json:"id"`
+		} `json:"users"`
+		Facets     api.UserFacets `json:"facets"`
+		TotalCount int            `json:"totalCount"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	if body.TotalCount != 1 || len(body.Users) != 1 || body.Users[0].ID != "user-1" {
+		t.Fatalf("unexpected users: %s", rec.Body.String())
+	}
+	if body.Facets.Grades["B2"] != 1 || body.Facets.Grades["B3"] != 1 {
+		t.Fatalf("grades facet = %v", body.Facets.Grades)
+	}
+	if body.Facets.Classes["C"] != 1 || body.Facets.Classes["A"] != 1 {
+		t.Fatalf("classes facet = %v", body.Facets.Classes)
+	}
+	if body.Facets.Courses["InformationDesign"] != 1 {
+		t.Fatalf("courses facet = %v", body.Facets.Courses)
+	}
+}
@@ -0,0 +1,187 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Status は依存先およびサーバー全体の状態です。
+type Status string
+
+const (
+	// StatusOK は正常に応答したことを表します。
+	StatusOK Status = "ok"
+	// StatusUnavailable は応答がない、またはエラーを返したことを表します。
+	StatusUnavailable Status = "unavailable"
+)
+
+// /readyz の error に返す失敗の理由です。
+const (
+	ReasonTimeout             = "timeout"
+	ReasonUnreachable         = "unreachable"
+	ReasonCredentialsRejected = "credentials rejected"
+	ReasonUpstreamError       = "upstream error"
+	ReasonMisconfigured       = "misconfigured"
+	ReasonFailed              = "failed"
+)
+
+// Check は依存先の状態を確認する関数です。正常な場合は nil を返します。
+// 失敗の理由を区別する場合は Fail で作成した *CheckError を返します。
+type Check func(ctx context.Context) error
+
+// CheckError は確認の失敗を、公開してよい理由と詳細なエラーに分けて保持します。
+// /readyz は認証なしで公開するため、Reason のみを返し、Err はログにのみ出力します。
+type CheckError struct {
+	Reason string
+	Err    error
+}
+
+func (e *CheckError) Error() string {
+	return e.Reason + ": " + e.Err.Error()
+}
+
+func (e *CheckError) Unwrap() error {
+	return e.Err
+}
+
+// Fail は reason を理由とする確認の失敗を作成します。
+func Fail(reason string, err error) error {
+	return &CheckError{Reason: reason, Err: err}
+}
+
+// CheckResult は依存先 1 件分の確認結果です。
+type CheckResult struct {
+	Status     Status `json:"status"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"durationMs"`
+}
+
+// Report は /readyz が返す依存先ごとの確認結果です。
+type Report struct {
+	Status Status                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker は登録された依存先を並行して確認します。
+type Checker struct {
+	timeout time.Duration
+	checks  []namedCheck
+}
+
+// NewChecker は各確認を timeout で打ち切る Checker を作成します。
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+// Add は name の依存先として check を登録します。
+func (c *Checker) Add(name string, check Check) {
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// AddAll は checks をまとめて登録します。名前の順に登録するため結果の順序は安定します。
+func (c *Checker) AddAll(checks map[string]func(context.Context) error) {
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		c.Add(name, checks[name])
+	}
+}
+
+// Run は全ての依存先を確認し、1 件でも失敗があれば全体を unavailable とします。
+func (c *Checker) Run(ctx context.Context) Report {
+	results := make([]CheckResult, len(c.checks))
+
+	var wg sync.WaitGroup
+	for i, nc := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.run(ctx, nc.name, nc.check)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusOK, Checks: make(map[string]CheckResult, len(c.checks))}
+	for i, nc := range c.checks {
+		report.Checks[nc.name] = results[i]
+		if results[i].Status != StatusOK {
+			report.Status = StatusUnavailable
+		}
+	}
+	return report
+}
+
+func (c *Checker) run(ctx context.Context, name string, check Check) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	errCh := make(chan error, 1)
+	go func() { errCh <- check(ctx) }()
+
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		// context を無視する確認があっても期限で打ち切る
+		err = ctx.Err()
+	}
+
+	result := CheckResult{Status: StatusOK, DurationMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		result.Status = StatusUnavailable
+		result.Error = reason(err)
+		slog.WarnContext(ctx, "Readiness check failed",
+			slog.String("check", name), slog.String("reason", result.Error), slog.Any("error", err))
+	}
+	return result
+}
+
+// reason エラーから /readyz に返す理由を決める
+// 上流の URL や接続先のアドレスを含みうるため、エラーメッセージ自体は返さない
+func reason(err error) string {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return ReasonTimeout
+	}
+	var checkErr *CheckError
+	if errors.As(err, &checkErr) {
+		return checkErr.Reason
+	}
+	return ReasonFailed
+}
+
+// Liveness はプロセスが起動していれば 200 を返す /healthz のハンドラです。
+func Liveness() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": StatusOK})
+	}
+}
+
+// Readiness は依存先を確認し、全て正常なら 200、それ以外は 503 を返す /readyz のハンドラです。
+func Readiness(checker *Checker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		report := checker.Run(c.Request.Context())
+		status := http.StatusOK
+		if report.Status != StatusOK {
+			status = http.StatusServiceUnavailable
+		}
+		c.JSON(status, report)
+	}
+}
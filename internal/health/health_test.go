@@ -0,0 +1,81 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestChecker_Run(t *testing.T) {
+	checker := NewChecker(50 * time.Millisecond)
+	checker.Add("spec", func(context.Context) error { return nil })
+	checker.Add("academic", func(context.Context) error {
+		return Fail(ReasonUnreachable, errors.New("dial tcp 10.0.0.1:443: connection refused"))
+	})
+	checker.Add("funch", func(context.Context) error { return errors.New("unexpected") })
+	checker.Add("user", func(context.Context) error {
+		// context を無視して期限を超えても打ち切られること
+		time.Sleep(time.Second)
+		return nil
+	})
+
+	report := checker.Run(context.Background())
+	if report.Status != StatusUnavailable {
+		t.Fatalf("status = %q, want %q", report.Status, StatusUnavailable)
+	}
+	if got := report.Checks["spec"]; got.Status != StatusOK || got.Error != "" {
+		t.Fatalf("spec = %+v", got)
+	}
+	if got := report.Checks["academic"]; got.Status != StatusUnavailable || got.Error != ReasonUnreachable {
+		t.Fatalf("academic = %+v", got)
+	}
+	if got := report.Checks["funch"]; got.Status != StatusUnavailable || got.Error != ReasonFailed {
+		t.Fatalf("funch = %+v", got)
+	}
+	if got := report.Checks["user"]; got.Status != StatusUnavailable || got.Error != ReasonTimeout {
+		t.Fatalf("user = %+v", got)
+	}
+}
+
+func TestReadiness(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "ready", wantStatus: http.StatusOK},
+		{name: "not ready", err: errors.New("down"), wantStatus: http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewChecker(time.Second)
+			checker.AddAll(map[string]func(context.Context) error{
+				"funch": func(context.Context) error { return tt.err },
+			})
+
+			router := gin.New()
+			router.GET("/readyz", Readiness(checker))
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			var report Report
+			if err := json.Unmarshal(rec.Body.Bytes(), &report); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if _, ok := report.Checks["funch"]; !ok {
+				t.Fatalf("checks = %+v, want funch", report.Checks)
+			}
+		})
+	}
+}
@@ -3,45 +3,99 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
-	"os"
-	"time"
 
 	"github.com/fun-dotto/admin-bff-api/generated/external/academic_api"
 	"github.com/fun-dotto/admin-bff-api/generated/external/announcement_api"
 	"github.com/fun-dotto/admin-bff-api/generated/external/funch_api"
 	"github.com/fun-dotto/admin-bff-api/generated/external/user_api"
+	"github.com/fun-dotto/admin-bff-api/internal/config"
+	"github.com/fun-dotto/admin-bff-api/internal/health"
+	"github.com/fun-dotto/admin-bff-api/internal/metrics"
+	"github.com/fun-dotto/admin-bff-api/internal/resilience"
 	"google.golang.org/api/idtoken"
 )
 
-const httpClientTimeout = 30 * time.Second
-
 // ExternalClients 外部APIクライアントをまとめて管理
 type ExternalClients struct {
 	Academic     *academic_api.ClientWithResponses
 	Announcement *announcement_api.ClientWithResponses
 	Funch        *funch_api.ClientWithResponses
 	User         *user_api.ClientWithResponses
+
+	upstreams []*upstream
+}
+
+// upstream 上流 API の接続先と認証付き HTTP クライアント
+type upstream struct {
+	service string
+	url     string
+	client  *http.Client
+	// probe 疎通確認用のクライアント
+	// 再試行やサーキットブレーカーを通すと、確認の失敗が実際のリクエストを遮断したり、
+	// ブレーカーの状態を上流の状態として報告したりするため、認証のみを共有する
+	probe *http.Client
 }
 
 // NewExternalClients 全ての外部APIクライアントを初期化
-func NewExternalClients(ctx context.Context) (*ExternalClients, error) {
-	academic, err := newAcademicClient(ctx)
+// 上流 API への呼び出しは m に service 名ごとに記録する
+// 開発モードでは ID トークンを付けずに接続する
+func NewExternalClients(ctx context.Context, cfg *config.Config, m *metrics.Metrics) (*ExternalClients, error) {
+	newUpstream := func(service string, upstreamCfg config.Upstream) (*upstream, error) {
+		return newAuthUpstream(ctx, m, service, upstreamCfg)
+	}
+	if cfg.Dev.Enabled {
+		newUpstream = func(service string, upstreamCfg config.Upstream) (*upstream, error) {
+			return newDevUpstream(m, service, upstreamCfg), nil
+		}
+	}
+
+	academicUpstream, err := newUpstream("academic", cfg.Upstreams.Academic)
+	if err != nil {
+		return nil, fmt.Errorf("academic client: %w", err)
+	}
+	academic, err := academic_api.NewClientWithResponses(
+		academicUpstream.url,
+		academic_api.WithHTTPClient(academicUpstream.client),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("academic client: %w", err)
 	}
 
-	announcement, err := newAnnouncementClient(ctx)
+	announcementUpstream, err := newUpstream("announcement", cfg.Upstreams.Announcement)
+	if err != nil {
+		return nil, fmt.Errorf("announcement client: %w", err)
+	}
+	announcement, err := announcement_api.NewClientWithResponses(
+		announcementUpstream.url,
+		announcement_api.WithHTTPClient(announcementUpstream.client),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("announcement client: %w", err)
 	}
 
-	funch, err := newFunchClient(ctx)
+	funchUpstream, err := newUpstream("funch", cfg.Upstreams.Funch)
+	if err != nil {
+		return nil, fmt.Errorf("funch client: %w", err)
+	}
+	funch, err := funch_api.NewClientWithResponses(
+		funchUpstream.url,
+		funch_api.WithHTTPClient(funchUpstream.client),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("funch client: %w", err)
 	}
 
-	user, err := newUserClient(ctx)
+	userUpstream, err := newUpstream("user", cfg.Upstreams.User)
+	if err != nil {
+		return nil, fmt.Errorf("user client: %w", err)
+	}
+	user, err := user_api.NewClientWithResponses(
+		userUpstream.url,
+		user_api.WithHTTPClient(userUpstream.client),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("user client: %w", err)
 	}
@@ -51,83 +105,100 @@ func NewExternalClients(ctx context.Context) (*ExternalClients, error) {
 		Announcement: announcement,
 		Funch:        funch,
 		User:         user,
+		upstreams:    []*upstream{academicUpstream, announcementUpstream, funchUpstream, userUpstream},
 	}, nil
 }
 
-func newFunchClient(ctx context.Context) (*funch_api.ClientWithResponses, error) {
-	url := os.Getenv("FUNCH_API_URL")
-	if url == "" {
-		return nil, fmt.Errorf("FUNCH_API_URL is required")
+// HealthChecks 上流 API ごとの疎通確認を service 名をキーにして返す
+func (c *ExternalClients) HealthChecks() map[string]func(context.Context) error {
+	checks := make(map[string]func(context.Context) error, len(c.upstreams))
+	for _, u := range c.upstreams {
+		checks[u.service] = u.ping
 	}
-
-	authClient, err := newAuthHTTPClient(ctx, url)
-	if err != nil {
-		return nil, err
-	}
-
-	return funch_api.NewClientWithResponses(
-		url,
-		funch_api.WithHTTPClient(authClient),
-	)
+	return checks
 }
 
-func newAcademicClient(ctx context.Context) (*academic_api.ClientWithResponses, error) {
-	url := os.Getenv("ACADEMIC_API_URL")
-	if url == "" {
-		return nil, fmt.Errorf("ACADEMIC_API_URL is required")
+// Close 上流 API へのアイドル状態の接続を閉じる
+// 処理中のリクエストが全て完了した後に呼び出す
+func (c *ExternalClients) Close() {
+	for _, u := range c.upstreams {
+		u.client.CloseIdleConnections()
+		u.probe.CloseIdleConnections()
 	}
+}
 
-	authClient, err := newAuthHTTPClient(ctx, url)
+// newAuthUpstream cfg.URL に対する認証付き HTTP クライアントを作成
+func newAuthUpstream(ctx context.Context, m *metrics.Metrics, service string, cfg config.Upstream) (*upstream, error) {
+	authTransport, err := newAuthTransport(ctx, cfg)
 	if err != nil {
 		return nil, err
 	}
-
-	return academic_api.NewClientWithResponses(
-		url,
-		academic_api.WithHTTPClient(authClient),
-	)
+	return &upstream{
+		service: service,
+		url:     cfg.URL,
+		client:  &http.Client{Transport: newUpstreamTransport(authTransport, m, service, cfg), Timeout: cfg.Timeout},
+		probe:   &http.Client{Transport: authTransport, Timeout: cfg.Timeout},
+	}, nil
 }
 
-func newAnnouncementClient(ctx context.Context) (*announcement_api.ClientWithResponses, error) {
-	url := os.Getenv("ANNOUNCEMENT_API_URL")
-	if url == "" {
-		return nil, fmt.Errorf("ANNOUNCEMENT_API_URL is required")
+// newDevUpstream 開発モード用に ID トークンを付けない HTTP クライアントを作成
+func newDevUpstream(m *metrics.Metrics, service string, cfg config.Upstream) *upstream {
+	return &upstream{
+		service: service,
+		url:     cfg.URL,
+		client:  &http.Client{Transport: newUpstreamTransport(http.DefaultTransport, m, service, cfg), Timeout: cfg.Timeout},
+		probe:   &http.Client{Transport: http.DefaultTransport, Timeout: cfg.Timeout},
 	}
-
-	authClient, err := newAuthHTTPClient(ctx, url)
-	if err != nil {
-		return nil, err
-	}
-
-	return announcement_api.NewClientWithResponses(
-		url,
-		announcement_api.WithHTTPClient(authClient),
-	)
 }
 
-func newUserClient(ctx context.Context) (*user_api.ClientWithResponses, error) {
-	url := os.Getenv("USER_API_URL")
-	if url == "" {
-		return nil, fmt.Errorf("USER_API_URL is required")
+// ping 上流 API のルートに GET を送り、ID トークンの取得と疎通を確認する
+// 再試行やサーキットブレーカーを通さず、上流の現在の状態をそのまま確認する
+// 5xx と認証エラー以外の応答は到達できたものとして扱う
+// エラーには上流の URL が含まれるため、/readyz には health.CheckError の理由のみが返る
+func (u *upstream) ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.url, nil)
+	if err != nil {
+		return health.Fail(health.ReasonMisconfigured, err)
 	}
-
-	authClient, err := newAuthHTTPClient(ctx, url)
+	resp, err := u.probe.Do(req)
 	if err != nil {
-		return nil, err
+		return health.Fail(health.ReasonUnreachable, err)
 	}
-
-	return user_api.NewClientWithResponses(
-		url,
-		user_api.WithHTTPClient(authClient),
-	)
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return health.Fail(health.ReasonCredentialsRejected, fmt.Errorf("%s rejected the BFF credentials with status %d", u.service, resp.StatusCode))
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return health.Fail(health.ReasonUpstreamError, fmt.Errorf("%s responded with status %d", u.service, resp.StatusCode))
+	}
+	return nil
 }
 
-// newAuthHTTPClient Google Cloud認証付きHTTPクライアントを作成
-func newAuthHTTPClient(ctx context.Context, targetURL string) (*http.Client, error) {
-	client, err := idtoken.NewClient(ctx, targetURL)
+// newAuthTransport Google Cloud の ID トークンを付与する RoundTripper を作成
+func newAuthTransport(ctx context.Context, cfg config.Upstream) (http.RoundTripper, error) {
+	client, err := idtoken.NewClient(ctx, cfg.URL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create auth client: %w", err)
 	}
-	client.Timeout = httpClientTimeout
-	return client, nil
+	return client.Transport, nil
+}
+
+// newUpstreamTransport base に上流 API 呼び出しに共通の処理を重ねた RoundTripper を作成
+// リクエスト ID は X-Request-ID として上流 API に引き継ぎ、試行ごとにメトリクスを記録する
+// 冪等なリクエストは再試行し、失敗が続く場合はサーキットブレーカーで即座に失敗させる
+// スパンは再試行を含めた呼び出し全体に対して 1 つ作成する
+func newUpstreamTransport(base http.RoundTripper, m *metrics.Metrics, service string, cfg config.Upstream) http.RoundTripper {
+	m.SetCircuitState(service, int(resilience.StateClosed))
+	breaker := resilience.NewBreaker(service, cfg.Breaker.FailureThreshold, cfg.Breaker.OpenDuration, func(state resilience.State) {
+		m.SetCircuitState(service, int(state))
+		slog.Warn("Upstream circuit breaker state changed", slog.String("service", service), slog.String("state", state.String()))
+	})
+	resilient := resilience.NewTransport(m.InstrumentTransport(withRequestID(base), service), breaker, resilience.RetryPolicy{
+		MaxAttempts:    cfg.Retry.MaxAttempts,
+		InitialBackoff: cfg.Retry.InitialBackoff,
+		OnRetry:        func() { m.ObserveUpstreamRetry(service) },
+	})
+	return withTracing(resilient, service)
 }
@@ -0,0 +1,113 @@
+package infrastructure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/internal/config"
+	"github.com/fun-dotto/admin-bff-api/internal/health"
+	"github.com/fun-dotto/admin-bff-api/internal/metrics"
+)
+
+func TestUpstreamPing(t *testing.T) {
+	tests := []struct {
+		status  int
+		wantErr bool
+	}{
+		{status: http.StatusOK},
+		{status: http.StatusNotFound},
+		{status: http.StatusForbidden, wantErr: true},
+		{status: http.StatusServiceUnavailable, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			u := &upstream{service: "academic", url: server.URL, probe: server.Client()}
+			if err := u.ping(context.Background()); (err != nil) != tt.wantErr {
+				t.Fatalf("ping() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReadinessDoesNotExposeUpstreamURL(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer rejecting.Close()
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	checker := health.NewChecker(time.Second)
+	upstreams := map[string]*upstream{
+		"academic": {service: "academic", url: rejecting.URL, probe: rejecting.Client()},
+		"user":     {service: "user", url: closed.URL, probe: http.DefaultClient},
+	}
+	for name, u := range upstreams {
+		checker.Add(name, u.ping)
+	}
+
+	router := gin.New()
+	router.GET("/readyz", health.Readiness(checker))
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	body := rec.Body.String()
+	for _, u := range upstreams {
+		parsed, err := url.Parse(u.url)
+		if err != nil {
+			t.Fatalf("parse upstream url: %v", err)
+		}
+		if strings.Contains(body, parsed.Host) {
+			t.Fatalf("/readyz exposes %s: %s", parsed.Host, body)
+		}
+	}
+	for _, want := range []string{health.ReasonCredentialsRejected, health.ReasonUnreachable} {
+		if !strings.Contains(body, `"error":"`+want+`"`) {
+			t.Fatalf("/readyz = %s, want reason %q", body, want)
+		}
+	}
+}
+
+func TestUpstreamPingBypassesCircuitBreaker(t *testing.T) {
+	status := http.StatusServiceUnavailable
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	u := newDevUpstream(metrics.New(), "academic", config.Upstream{
+		URL:     server.URL,
+		Timeout: time.Second,
+		Retry:   config.Retry{MaxAttempts: 1},
+		Breaker: config.Breaker{FailureThreshold: 1, OpenDuration: time.Minute},
+	})
+	for range 3 {
+		if err := u.ping(context.Background()); err == nil {
+			t.Fatal("ping() must fail while upstream returns 503")
+		}
+	}
+
+	status = http.StatusOK
+	if err := u.ping(context.Background()); err != nil {
+		t.Fatalf("ping() after recovery = %v", err)
+	}
+	resp, err := u.client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("failed probes must not open the circuit breaker: %v", err)
+	}
+	_ = resp.Body.Close()
+}
@@ -0,0 +1,62 @@
+package infrastructure
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/fun-dotto/admin-bff-api/internal/logging"
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+)
+
+// requestIDTransport は context のリクエスト ID を X-Request-ID として上流 API に引き継ぐ RoundTripper
+type requestIDTransport struct {
+	base http.RoundTripper
+}
+
+func (t *requestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestID := logging.RequestIDFromContext(req.Context())
+	if requestID == "" || req.Header.Get(problem.RequestIDHeader) != "" {
+		return t.base.RoundTrip(req)
+	}
+
+	// RoundTripper はリクエストを変更してはならないため複製してからヘッダーを付与する
+	cloned := req.Clone(req.Context())
+	cloned.Header.Set(problem.RequestIDHeader, requestID)
+	return t.base.RoundTrip(cloned)
+}
+
+// withRequestID base を requestIDTransport で包む
+func withRequestID(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &requestIDTransport{base: base}
+}
+
+// urlPathTransport otelhttp が作成したクライアントスパンに、リクエストのパスを url.path 属性として記録する RoundTripper
+type urlPathTransport struct {
+	base http.RoundTripper
+}
+
+func (t *urlPathTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	trace.SpanFromContext(req.Context()).SetAttributes(attribute.String("url.path", req.URL.Path))
+	return t.base.RoundTrip(req)
+}
+
+// withTracing base を OpenTelemetry の計装で包む
+// 上流 API への呼び出しごとに子スパンを作成し、W3C Trace Context をヘッダーで伝播する
+// パスにはリソース ID が含まれるため、スパン名はサービス名とメソッドに留め、パスは url.path 属性に記録する
+func withTracing(base http.RoundTripper, service string) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return otelhttp.NewTransport(&urlPathTransport{base: base},
+		otelhttp.WithSpanNameFormatter(func(_ string, req *http.Request) string {
+			return service + " " + req.Method
+		}),
+		otelhttp.WithSpanOptions(trace.WithAttributes(attribute.String("peer.service", service))),
+	)
+}
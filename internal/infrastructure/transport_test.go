@@ -0,0 +1,112 @@
+package infrastructure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/fun-dotto/admin-bff-api/internal/logging"
+	"github.com/fun-dotto/admin-bff-api/internal/middleware"
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+)
+
+func TestRequestIDTransport_PropagatesRequestID(t *testing.T) {
+	var gotRequestID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequestID = r.Header.Get(problem.RequestIDHeader)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: withRequestID(nil)}
+	ctx := logging.WithRequestID(context.Background(), "req-1")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("do: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if gotRequestID != "req-1" {
+		t.Fatalf("X-Request-ID = %q, want %q", gotRequestID, "req-1")
+	}
+	if req.Header.Get(problem.RequestIDHeader) != "" {
+		t.Fatal("transport must not modify the original request")
+	}
+}
+
+func TestWithTracing_CreatesChildSpanAndPropagatesTraceContext(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+
+	var gotTraceparent string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTraceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer upstream.Close()
+
+	client := &http.Client{Transport: withTracing(withRequestID(nil), "academic")}
+	router := gin.New()
+	router.Use(middleware.Tracing(), func(c *gin.Context) {
+		c.Set(middleware.OperationIDContextKey, "RoomsV1_list")
+		c.Next()
+	})
+	router.GET("/v1/rooms", func(c *gin.Context) {
+		req, _ := http.NewRequestWithContext(c.Request.Context(), http.MethodGet, upstream.URL+"/v1/rooms", nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Errorf("upstream call: %v", err)
+			return
+		}
+		_ = resp.Body.Close()
+		c.Status(http.StatusOK)
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/rooms", nil))
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("len(spans) = %d, want 2", len(spans))
+	}
+	clientSpan, server := spans[0], spans[1]
+	if server.Name != "RoomsV1_list" || server.SpanKind != trace.SpanKindServer {
+		t.Fatalf("server span = %q (%v)", server.Name, server.SpanKind)
+	}
+	if clientSpan.Name != "academic GET" || clientSpan.SpanKind != trace.SpanKindClient {
+		t.Fatalf("client span = %q (%v)", clientSpan.Name, clientSpan.SpanKind)
+	}
+	if !slices.Contains(clientSpan.Attributes, attribute.String("url.path", "/v1/rooms")) {
+		t.Fatalf("client span attributes = %v, want url.path", clientSpan.Attributes)
+	}
+	if clientSpan.Parent.SpanID() != server.SpanContext.SpanID() {
+		t.Fatal("client span is not a child of the server span")
+	}
+	if !strings.Contains(gotTraceparent, server.SpanContext.TraceID().String()) {
+		t.Fatalf("traceparent = %q, want trace id %s", gotTraceparent, server.SpanContext.TraceID())
+	}
+}
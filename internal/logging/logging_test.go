@@ -0,0 +1,44 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+)
+
+func TestNewLogger_WritesCloudLoggingFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger(&buf, slog.LevelInfo)
+
+	ctx := WithRequestID(context.Background(), "req-1")
+	logger.WarnContext(ctx, "upstream slow", slog.String("service", "academic"))
+	logger.DebugContext(ctx, "suppressed")
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("unmarshal log: %v (%s)", err, buf.String())
+	}
+	if entry["severity"] != "WARNING" || entry["message"] != "upstream slow" {
+		t.Fatalf("unexpected log entry: %v", entry)
+	}
+	if entry["requestId"] != "req-1" || entry["service"] != "academic" {
+		t.Fatalf("unexpected log entry: %v", entry)
+	}
+	if _, ok := entry["level"]; ok {
+		t.Fatalf("level should be replaced by severity: %v", entry)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	if level, err := ParseLevel("DEBUG"); err != nil || level != slog.LevelDebug {
+		t.Fatalf("ParseLevel(DEBUG) = %v, %v", level, err)
+	}
+	if level, err := ParseLevel(""); err != nil || level != slog.LevelInfo {
+		t.Fatalf("ParseLevel(\"\") = %v, %v", level, err)
+	}
+	if _, err := ParseLevel("verbose"); err == nil {
+		t.Fatal("ParseLevel(verbose) should fail")
+	}
+}
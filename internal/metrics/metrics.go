@@ -0,0 +1,153 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "admin_bff"
+
+// UnknownOperation は operationId を特定できなかったリクエストに付与するラベル値です。
+const UnknownOperation = "unknown"
+
+// Metrics は BFF が公開する Prometheus メトリクスをまとめて管理します。
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests         *prometheus.CounterVec
+	requestDuration  *prometheus.HistogramVec
+	inFlight         prometheus.Gauge
+	upstreamRequests *prometheus.CounterVec
+	upstreamDuration *prometheus.HistogramVec
+	upstreamRetries  *prometheus.CounterVec
+	circuitState     *prometheus.GaugeVec
+	authFailures     *prometheus.CounterVec
+}
+
+// New は専用の Registry にメトリクスを登録した Metrics を作成します。
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "Number of HTTP requests handled by the BFF, by operationId and status class.",
+		}, []string{"operation", "method", "status_class"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Latency of HTTP requests handled by the BFF, by operationId and status class.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation", "status_class"}),
+		inFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "http_requests_in_flight",
+			Help:      "Number of HTTP requests currently being handled by the BFF.",
+		}),
+		upstreamRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "upstream_requests_total",
+			Help:      "Number of requests sent to upstream APIs, by service and status class.",
+		}, []string{"service", "method", "status_class"}),
+		upstreamDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "upstream_request_duration_seconds",
+			Help:      "Latency of requests sent to upstream APIs, by service and status class.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"service", "status_class"}),
+		upstreamRetries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "upstream_retries_total",
+			Help:      "Number of retried requests to upstream APIs, by service.",
+		}, []string{"service"}),
+		circuitState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "upstream_circuit_state",
+			Help:      "Circuit breaker state per upstream service (0: closed, 1: half-open, 2: open).",
+		}, []string{"service"}),
+		authFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "auth_failures_total",
+			Help:      "Number of rejected authentications and authorizations, by reason.",
+		}, []string{"reason"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests,
+		m.requestDuration,
+		m.inFlight,
+		m.upstreamRequests,
+		m.upstreamDuration,
+		m.upstreamRetries,
+		m.circuitState,
+		m.authFailures,
+	)
+	return m
+}
+
+// Registry はメトリクスを登録している Registry を返します。
+// BFF 以外のコンポーネントが独自のメトリクスを追加する場合に使用します。
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+// Handler は Prometheus のテキスト形式でメトリクスを返す http.Handler を返します。
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// RequestStarted は処理中のリクエスト数を増やし、完了時に呼び出す関数を返します。
+func (m *Metrics) RequestStarted() func() {
+	m.inFlight.Inc()
+	return m.inFlight.Dec
+}
+
+// ObserveRequest は BFF が処理したリクエストを記録します。
+func (m *Metrics) ObserveRequest(operation, method string, status int, duration time.Duration) {
+	if operation == "" {
+		operation = UnknownOperation
+	}
+	class := StatusClass(status)
+	m.requests.WithLabelValues(operation, method, class).Inc()
+	m.requestDuration.WithLabelValues(operation, class).Observe(duration.Seconds())
+}
+
+// ObserveUpstream は上流 API への呼び出しを記録します。
+// レスポンスを受け取れなかった場合は status に 0 を渡します。
+func (m *Metrics) ObserveUpstream(service, method string, status int, duration time.Duration) {
+	class := StatusClass(status)
+	m.upstreamRequests.WithLabelValues(service, method, class).Inc()
+	m.upstreamDuration.WithLabelValues(service, class).Observe(duration.Seconds())
+}
+
+// ObserveUpstreamRetry は上流 API への再試行を記録します。
+func (m *Metrics) ObserveUpstreamRetry(service string) {
+	m.upstreamRetries.WithLabelValues(service).Inc()
+}
+
+// SetCircuitState は上流 API のサーキットブレーカーの状態を記録します。
+// state は 0: closed、1: half-open、2: open です。
+func (m *Metrics) SetCircuitState(service string, state int) {
+	m.circuitState.WithLabelValues(service).Set(float64(state))
+}
+
+// ObserveAuthFailure は認証・認可の失敗を理由ごとに記録します。
+func (m *Metrics) ObserveAuthFailure(reason string) {
+	m.authFailures.WithLabelValues(reason).Inc()
+}
+
+// StatusClass はステータスコードを 2xx / 4xx などのクラスに変換します。
+// 0 はレスポンスを受け取れなかったことを表し error を返します。
+func StatusClass(status int) string {
+	if status < 100 || status > 599 {
+		return "error"
+	}
+	return strconv.Itoa(status/100) + "xx"
+}
@@ -0,0 +1,82 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestStatusClass(t *testing.T) {
+	tests := map[int]string{
+		0:   "error",
+		200: "2xx",
+		204: "2xx",
+		404: "4xx",
+		502: "5xx",
+		999: "error",
+	}
+	for status, want := range tests {
+		if got := StatusClass(status); got != want {
+			t.Errorf("StatusClass(%d) = %q, want %q", status, got, want)
+		}
+	}
+}
+
+func TestInstrumentTransport_RecordsUpstreamCalls(t *testing.T) {
+	m := New()
+
+	ok := m.InstrumentTransport(roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: http.NoBody}, nil
+	}), "academic")
+	failing := m.InstrumentTransport(roundTripFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	}), "user")
+
+	req := httptest.NewRequest(http.MethodGet, "http://upstream.example/v1/rooms", nil)
+	if _, err := ok.RoundTrip(req); err != nil {
+		t.Fatalf("round trip: %v", err)
+	}
+	if _, err := failing.RoundTrip(req); err == nil {
+		t.Fatal("expected error from failing transport")
+	}
+
+	if got := testutil.ToFloat64(m.upstreamRequests.WithLabelValues("academic", http.MethodGet, "5xx")); got != 1 {
+		t.Fatalf("academic 5xx = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(m.upstreamRequests.WithLabelValues("user", http.MethodGet, "error")); got != 1 {
+		t.Fatalf("user error = %v, want 1", got)
+	}
+}
+
+func TestHandler_ExposesMetrics(t *testing.T) {
+	m := New()
+	m.ObserveRequest("", http.MethodGet, http.StatusOK, 0)
+	m.ObserveAuthFailure("TOKEN_EXPIRED")
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d", rec.Code)
+	}
+
+	body := rec.Body.String()
+	for _, want := range []string{
+		`admin_bff_http_requests_total{method="GET",operation="unknown",status_class="2xx"} 1`,
+		`admin_bff_auth_failures_total{reason="TOKEN_EXPIRED"} 1`,
+		`admin_bff_http_requests_in_flight 0`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics output does not contain %q", want)
+		}
+	}
+}
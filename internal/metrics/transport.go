@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+)
+
+// upstreamTransport は上流 API への呼び出しの件数とレイテンシを記録する RoundTripper です。
+type upstreamTransport struct {
+	base    http.RoundTripper
+	metrics *Metrics
+	service string
+}
+
+// InstrumentTransport は base を service ラベル付きのメトリクス記録で包みます。
+func (m *Metrics) InstrumentTransport(base http.RoundTripper, service string) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &upstreamTransport{base: base, metrics: m, service: service}
+}
+
+func (t *upstreamTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+
+	status := 0
+	if err == nil {
+		status = resp.StatusCode
+	}
+	t.metrics.ObserveUpstream(t.service, req.Method, status, time.Since(start))
+	return resp, err
+}
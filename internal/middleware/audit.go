@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/internal/audit"
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+)
+
+// maxAuditBodySize は監査ログに記録するリクエスト・レスポンスボディの最大サイズです。
+const maxAuditBodySize = 64 << 10
+
+type auditResourceIDKey struct{}
+
+// AuditResourceIDContextKey は Gin の context に監査ログへ記録するリソース ID を格納するキーです。
+var AuditResourceIDContextKey = auditResourceIDKey{}
+
+// SetAuditResourceID は作成したリソースの ID を監査ログに記録するために設定します。
+// 作成系のレスポンスに作成したリソース以外の項目も含まれ、ボディから ID を特定できない場合にハンドラーから呼び出します。
+func SetAuditResourceID(c *gin.Context, id string) {
+	c.Set(AuditResourceIDContextKey, id)
+}
+
+// Audit は GET / HEAD / OPTIONS 以外のリクエストを監査ログとして sink に記録する Gin ミドルウェアです。
+// OpenAPIValidator の後に登録し、認証済みのリクエストのみを記録します。
+func Audit(sink audit.Sink) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		}
+
+		requestBody := readRequestBody(c)
+		writer := &auditResponseWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		c.Next()
+
+		resourceID := c.Param("id")
+		if resourceID == "" {
+			resourceID = c.GetString(AuditResourceIDContextKey)
+		}
+		if resourceID == "" {
+			resourceID = createdResourceID(writer.body.Bytes())
+		}
+
+		entry := audit.Entry{
+			Timestamp:   time.Now().UTC(),
+			RequestID:   c.Writer.Header().Get(problem.RequestIDHeader),
+			ActorUID:    GetFirebaseUID(c),
+			OperationID: GetOperationID(c),
+			Method:      c.Request.Method,
+			Path:        c.Request.URL.Path,
+			Query:       c.Request.URL.RawQuery,
+			ResourceID:  resourceID,
+			RequestBody: requestBody,
+			StatusCode:  c.Writer.Status(),
+		}
+		if err := sink.Write(c.Request.Context(), entry); err != nil {
+			slog.ErrorContext(c.Request.Context(), "Failed to write audit log", slog.Any("error", err))
+		}
+	}
+}
+
+// readRequestBody はリクエストボディを読み取り、後続のハンドラが再度読めるように戻します。
+// JSON でない場合は文字列として、上限を超える場合は切り詰めた文字列として記録します。
+func readRequestBody(c *gin.Context) json.RawMessage {
+	if c.Request.Body == nil {
+		return nil
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return nil
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	if len(body) == 0 {
+		return nil
+	}
+	if len(body) <= maxAuditBodySize && json.Valid(body) {
+		return body
+	}
+	if len(body) > maxAuditBodySize {
+		body = body[:maxAuditBodySize]
+	}
+	encoded, err := json.Marshal(string(body))
+	if err != nil {
+		return nil
+	}
+	return encoded
+}
+
+// createdResourceID は作成系レスポンス {"<resource>": {"id": "..."}} からリソース ID を取り出します。
+func createdResourceID(body []byte) string {
+	var response map[string]json.RawMessage
+	if err := json.Unmarshal(body, &response); err != nil || len(response) != 1 {
+		return ""
+	}
+	for _, raw := range response {
+		var resource struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(raw, &resource); err == nil {
+			return resource.ID
+		}
+	}
+	return ""
+}
+
+// auditResponseWriter はリソース ID を取り出すためにレスポンスボディを上限まで保持します。
+type auditResponseWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *auditResponseWriter) Write(data []byte) (int, error) {
+	if remaining := maxAuditBodySize - w.body.Len(); remaining > 0 {
+		w.body.Write(data[:min(len(data), remaining)])
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *auditResponseWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
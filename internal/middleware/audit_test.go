@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	firebaseauth "firebase.google.com/go/v4/auth"
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/internal/audit"
+)
+
+func newAuditTestRouter(sink audit.Sink) *gin.Engine {
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set(FirebaseTokenContextKey, &firebaseauth.Token{UID: "admin-uid"})
+		c.Set(OperationIDContextKey, "RoomsV1_test")
+		c.Next()
+	}, Audit(sink))
+	router.GET("/v1/rooms/:id", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"room": gin.H{"id": c.Param("id")}})
+	})
+	router.PUT("/v1/rooms/:id", func(c *gin.Context) {
+		body, _ := c.GetRawData()
+		c.Data(http.StatusOK, "application/json", []byte(`{"room":`+string(body)+`}`))
+	})
+	router.POST("/v1/notifications/dispatch", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"dispatched": c.Query("dryRun") != "true"})
+	})
+	router.POST("/v1/notifications/audience", func(c *gin.Context) {
+		SetAuditResourceID(c, "notification-1")
+		c.JSON(http.StatusCreated, gin.H{"notification": gin.H{"id": "notification-1"}, "userCount": 2})
+	})
+	router.POST("/v1/rooms", func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"room": gin.H{"id": "room-new"}})
+	})
+	return router
+}
+
+func TestAudit_RecordsMutatingRequests(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	sink := audit.NewMemorySink(10)
+	router := newAuditTestRouter(sink)
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/v1/rooms/room-1", nil),
+		httptest.NewRequest(http.MethodPut, "/v1/rooms/room-1", strings.NewReader(`{"name":"363"}`)),
+		httptest.NewRequest(http.MethodPost, "/v1/rooms", strings.NewReader(`{"name":"364"}`)),
+	} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		if rec.Code >= 300 {
+			t.Fatalf("%s %s: status = %d", req.Method, req.URL.Path, rec.Code)
+		}
+	}
+
+	entries, err := sink.Recent(context.Background(), audit.Filter{})
+	if err != nil {
+		t.Fatalf("recent: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("len(entries) = %d, want 2: %+v", len(entries), entries)
+	}
+
+	created, updated := entries[0], entries[1]
+	if created.Method != http.MethodPost || created.ResourceID != "room-new" || created.StatusCode != http.StatusCreated {
+		t.Fatalf("unexpected create entry: %+v", created)
+	}
+	if updated.ResourceID != "room-1" || string(updated.RequestBody) != `{"name":"363"}` {
+		t.Fatalf("unexpected update entry: %+v", updated)
+	}
+	if updated.ActorUID != "admin-uid" || updated.OperationID != "RoomsV1_test" || updated.Timestamp.IsZero() {
+		t.Fatalf("unexpected update entry: %+v", updated)
+	}
+}
+
+func TestAudit_RecordsQueryToDistinguishDryRun(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	sink := audit.NewMemorySink(10)
+	router := newAuditTestRouter(sink)
+
+	for _, target := range []string{"/v1/notifications/dispatch?dryRun=true", "/v1/notifications/dispatch"} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, target, strings.NewReader(`{"ids":["n1"]}`)))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("POST %s: status = %d", target, rec.Code)
+		}
+	}
+
+	entries, err := sink.Recent(context.Background(), audit.Filter{})
+	if err != nil {
+		t.Fatalf("recent: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("len(entries) = %d, want 2: %+v", len(entries), entries)
+	}
+	dispatched, dryRun := entries[0], entries[1]
+	if dryRun.Query != "dryRun=true" || dispatched.Query != "" {
+		t.Fatalf("dry run and dispatch must be distinguishable: dryRun = %+v, dispatched = %+v", dryRun, dispatched)
+	}
+}
+
+func TestAudit_PrefersResourceIDSetByHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	sink := audit.NewMemorySink(10)
+	router := newAuditTestRouter(sink)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/notifications/audience", strings.NewReader(`{}`)))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d", rec.Code)
+	}
+
+	entries, err := sink.Recent(context.Background(), audit.Filter{})
+	if err != nil {
+		t.Fatalf("recent: %v", err)
+	}
+	if len(entries) != 1 || entries[0].ResourceID != "notification-1" {
+		t.Fatalf("entries = %+v", entries)
+	}
+}
@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/internal/cache"
+)
+
+// CacheStatusHeader はレスポンスがキャッシュから返されたかを示すヘッダーです。
+const CacheStatusHeader = "X-Cache"
+
+// ResponseCache は TTL が設定された operation の GET レスポンスをキャッシュする Gin ミドルウェアです。
+// 成功した作成・更新・削除は同じリソース種別のキャッシュを破棄します。
+// OpenAPIValidator の後に登録し、認可済みのリクエストのみをキャッシュから返します。
+// spec は配列のクエリパラメータを判別してキャッシュキーを正規化するために使用します。
+func ResponseCache(responses *cache.Cache, spec *openapi3.T) gin.HandlerFunc {
+	arrayParams := arrayQueryParams(spec)
+	return func(c *gin.Context) {
+		resource := cache.ResourceOf(c.Request.URL.Path)
+
+		switch c.Request.Method {
+		case http.MethodGet:
+		case http.MethodHead, http.MethodOptions:
+			c.Next()
+			return
+		default:
+			c.Next()
+			if status := c.Writer.Status(); status >= 200 && status < 300 {
+				responses.Invalidate(resource)
+			}
+			return
+		}
+
+		operationID := GetOperationID(c)
+		ttl, ok := responses.TTL(operationID)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		key := operationID + "?" + normalizedQuery(c.Request.URL.Query(), arrayParams[operationID])
+		if entry, ok := responses.Get(key); ok {
+			for name, values := range entry.Header {
+				c.Writer.Header()[name] = slices.Clone(values)
+			}
+			c.Header(CacheStatusHeader, "HIT")
+			c.Data(entry.StatusCode, entry.Header.Get("Content-Type"), entry.Body)
+			c.Abort()
+			return
+		}
+
+		c.Header(CacheStatusHeader, "MISS")
+		writer := &cacheResponseWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+
+		if writer.Status() == http.StatusOK {
+			responses.Set(key, resource, cache.Entry{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {writer.Header().Get("Content-Type")}},
+				Body:       writer.body.Bytes(),
+			}, ttl)
+		}
+	}
+}
+
+// normalizedQuery はキー順に並べたクエリ文字列を返します。
+// 配列パラメータは要素を並べ替えるため、順序だけが異なるリクエストは同じキーになります。
+func normalizedQuery(query url.Values, arrays map[string]bool) string {
+	normalized := make(url.Values, len(query))
+	for key, values := range query {
+		if !arrays[key] {
+			normalized[key] = values
+			continue
+		}
+		var items []string
+		for _, value := range values {
+			for item := range strings.SplitSeq(value, ",") {
+				if item != "" {
+					items = append(items, item)
+				}
+			}
+		}
+		slices.Sort(items)
+		normalized[key] = []string{strings.Join(slices.Compact(items), ",")}
+	}
+	return normalized.Encode()
+}
+
+// arrayQueryParams は operationId ごとに配列型のクエリパラメータ名を返します。
+func arrayQueryParams(spec *openapi3.T) map[string]map[string]bool {
+	params := make(map[string]map[string]bool)
+	for _, pathItem := range spec.Paths.Map() {
+		for _, operation := range pathItem.Operations() {
+			for _, ref := range operation.Parameters {
+				p := ref.Value
+				if p == nil || p.In != openapi3.ParameterInQuery || p.Schema == nil || p.Schema.Value == nil ||
+					!p.Schema.Value.Type.Is(openapi3.TypeArray) {
+					continue
+				}
+				if params[operation.OperationID] == nil {
+					params[operation.OperationID] = make(map[string]bool)
+				}
+				params[operation.OperationID][p.Name] = true
+			}
+		}
+	}
+	return params
+}
+
+// cacheResponseWriter はキャッシュに保存するためにレスポンスボディを保持します。
+type cacheResponseWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *cacheResponseWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *cacheResponseWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/internal/cache"
+	"github.com/fun-dotto/admin-bff-api/openapi"
+)
+
+func TestResponseCache(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	spec, err := openapi3.NewLoader().LoadFromData(openapi.Spec)
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+
+	upstreamCalls := 0
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		if c.Request.Method == http.MethodGet {
+			c.Set(OperationIDContextKey, "RoomsV1_list")
+		} else {
+			c.Set(OperationIDContextKey, "RoomsV1_update")
+		}
+		c.Next()
+	}, ResponseCache(cache.New(map[string]time.Duration{"RoomsV1_list": time.Minute}), spec))
+	router.GET("/v1/rooms", func(c *gin.Context) {
+		upstreamCalls++
+		c.JSON(http.StatusOK, gin.H{"rooms": []any{}})
+	})
+	router.PUT("/v1/rooms/:id", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"room": gin.H{"id": c.Param("id")}})
+	})
+
+	serve := func(method, target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(method, target, nil))
+		return rec
+	}
+
+	if rec := serve(http.MethodGet, "/v1/rooms?q=363&floors=Floor2,Floor1"); rec.Header().Get(CacheStatusHeader) != "MISS" {
+		t.Fatalf("first request: X-Cache = %q, want MISS", rec.Header().Get(CacheStatusHeader))
+	}
+	rec := serve(http.MethodGet, "/v1/rooms?floors=Floor1,Floor2&q=363")
+	if rec.Header().Get(CacheStatusHeader) != "HIT" || rec.Body.String() != `{"rooms":[]}` {
+		t.Fatalf("reordered request: X-Cache = %q, body = %s", rec.Header().Get(CacheStatusHeader), rec.Body.String())
+	}
+	if rec.Header().Get("Content-Type") != "application/json; charset=utf-8" {
+		t.Fatalf("Content-Type = %q", rec.Header().Get("Content-Type"))
+	}
+	if rec := serve(http.MethodGet, "/v1/rooms?q=363,364"); rec.Header().Get(CacheStatusHeader) != "MISS" {
+		t.Fatal("free-text parameters must not be reordered")
+	}
+
+	serve(http.MethodPut, "/v1/rooms/room-1")
+	if rec := serve(http.MethodGet, "/v1/rooms?q=363&floors=Floor1,Floor2"); rec.Header().Get(CacheStatusHeader) != "MISS" {
+		t.Fatal("update must invalidate cached rooms")
+	}
+	if upstreamCalls != 3 {
+		t.Fatalf("upstream calls = %d, want 3", upstreamCalls)
+	}
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"firebase.google.com/go/v4/auth"
+)
+
+var testDevSecret = []byte("0123456789abcdef0123456789abcdef")
+
+type stubVerifier struct {
+	token *auth.Token
+}
+
+func (s stubVerifier) VerifyIDToken(context.Context, string) (*auth.Token, error) {
+	return s.token, nil
+}
+
+func TestDevTokenVerifier(t *testing.T) {
+	verifier := NewDevTokenVerifier(testDevSecret, nil)
+
+	signed, err := SignDevToken(testDevSecret, "dev-user", map[string]any{"admin": true}, 0)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	token, err := verifier.VerifyIDToken(context.Background(), signed)
+	if err != nil {
+		t.Fatalf("VerifyIDToken() error = %v", err)
+	}
+	if token.UID != "dev-user" || !hasAnyClaim(token, "admin") || token.Expires != 0 {
+		t.Fatalf("unexpected token: %+v", token)
+	}
+
+	tests := []struct {
+		name     string
+		secret   []byte
+		ttl      time.Duration
+		wantCode string
+	}{
+		{name: "wrong secret", secret: []byte("fedcba9876543210fedcba9876543210"), ttl: 0, wantCode: errorCodeTokenInvalid},
+		{name: "expired", secret: testDevSecret, ttl: -time.Minute, wantCode: errorCodeTokenExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signed, err := SignDevToken(tt.secret, "dev-user", nil, tt.ttl)
+			if err != nil {
+				t.Fatalf("sign: %v", err)
+			}
+			_, err = verifier.VerifyIDToken(context.Background(), signed)
+			var authErr *AuthenticationError
+			if !errors.As(err, &authErr) || authErr.Code != tt.wantCode {
+				t.Fatalf("VerifyIDToken() error = %v, want code %s", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestDevTokenVerifier_DelegatesNonDevTokens(t *testing.T) {
+	emulatorToken := &auth.Token{UID: "emulator-user"}
+	verifier := NewDevTokenVerifier(testDevSecret, stubVerifier{token: emulatorToken})
+
+	// Firebase Auth エミュレータは alg: none の未署名トークンを発行する
+	unsigned := "eyJhbGciOiJub25lIiwidHlwIjoiSldUIn0.eyJzdWIiOiJlbXVsYXRvci11c2VyIn0."
+	token, err := verifier.VerifyIDToken(context.Background(), unsigned)
+	if err != nil || token != emulatorToken {
+		t.Fatalf("VerifyIDToken() = %+v, %v; want emulator token", token, err)
+	}
+}
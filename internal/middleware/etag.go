@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/internal/etag"
+)
+
+// ETag は成功した GET / PUT / POST のレスポンスに強い ETag を付与する Gin ミドルウェアです。
+// GET で If-None-Match が一致する場合はボディを返さず 304 を返します。
+// ETag を計算するためにレスポンスボディを全てバッファするため、ResponseCache より前に登録します。
+func ETag() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodPut, http.MethodPost:
+		default:
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		writer := &etagResponseWriter{ResponseWriter: original}
+		c.Writer = writer
+		c.Next()
+		c.Writer = original
+
+		status := original.Status()
+		if status == http.StatusOK || status == http.StatusCreated {
+			tag := etag.Compute(writer.body.Bytes())
+			original.Header().Set("ETag", tag)
+			if c.Request.Method == http.MethodGet && etag.MatchesIfNoneMatch(c.GetHeader("If-None-Match"), tag) {
+				original.Header().Del("Content-Type")
+				original.Header().Del("Content-Length")
+				original.WriteHeader(http.StatusNotModified)
+				original.WriteHeaderNow()
+				return
+			}
+		}
+		if writer.body.Len() > 0 {
+			_, _ = original.Write(writer.body.Bytes())
+		}
+	}
+}
+
+// etagResponseWriter は ETag を計算し終えるまでレスポンスボディを書き込まずに保持します。
+type etagResponseWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *etagResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *etagResponseWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *etagResponseWriter) Written() bool {
+	return w.body.Len() > 0 || w.ResponseWriter.Written()
+}
+
+func (w *etagResponseWriter) Size() int {
+	if w.body.Len() > 0 {
+		return w.body.Len()
+	}
+	return w.ResponseWriter.Size()
+}
+
+// WriteHeaderNow はボディを書き込むまでヘッダーの送信を遅らせます。
+func (w *etagResponseWriter) WriteHeaderNow() {}
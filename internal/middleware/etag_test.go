@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestETag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(ETag())
+	router.GET("/v1/rooms/:id", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"room": gin.H{"id": c.Param("id")}})
+	})
+	router.GET("/v1/missing", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"detail": "not found"})
+	})
+	router.DELETE("/v1/rooms/:id", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	serve := func(method, target, ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	first := serve(http.MethodGet, "/v1/rooms/room-1", "")
+	tag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || tag == "" || first.Body.String() != `{"room":{"id":"room-1"}}` {
+		t.Fatalf("first request: status = %d, ETag = %q, body = %s", first.Code, tag, first.Body.String())
+	}
+
+	rec := serve(http.MethodGet, "/v1/rooms/room-1", tag)
+	if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 || rec.Header().Get("ETag") != tag {
+		t.Fatalf("conditional request: status = %d, ETag = %q, body = %s", rec.Code, rec.Header().Get("ETag"), rec.Body.String())
+	}
+
+	rec = serve(http.MethodGet, "/v1/rooms/room-2", tag)
+	if rec.Code != http.StatusOK || rec.Header().Get("ETag") == tag {
+		t.Fatalf("different resource: status = %d, ETag = %q", rec.Code, rec.Header().Get("ETag"))
+	}
+
+	rec = serve(http.MethodGet, "/v1/missing", "*")
+	if rec.Code != http.StatusNotFound || rec.Header().Get("ETag") != "" {
+		t.Fatalf("error response: status = %d, ETag = %q", rec.Code, rec.Header().Get("ETag"))
+	}
+
+	rec = serve(http.MethodDelete, "/v1/rooms/room-1", "")
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("DELETE: status = %d", rec.Code)
+	}
+}
@@ -10,23 +10,33 @@ import (
 	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/gin-gonic/gin"
 	ginmiddleware "github.com/oapi-codegen/gin-middleware"
+
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
 )
 
 type firebaseTokenKey struct{}
-type authErrorStatusKey struct{}
-type authErrorMessageKey struct{}
+type authErrorKey struct{}
 
 // FirebaseTokenContextKey は Gin の context および context.Context に
 // Firebase ID トークンの検証結果を格納するキーです。
 var FirebaseTokenContextKey = firebaseTokenKey{}
 
-var (
-	authenticationErrorStatusKey  = authErrorStatusKey{}
-	authenticationErrorMessageKey = authErrorMessageKey{}
+var authenticationErrorKey = authErrorKey{}
+
+// 認証・認可の失敗時に返すエラーコード
+const (
+	errorCodeAuthenticationFailed   = "AUTHENTICATION_FAILED"
+	errorCodeAuthenticationRequired = "AUTHENTICATION_REQUIRED"
+	errorCodeTokenExpired           = "TOKEN_EXPIRED"
+	errorCodeTokenInvalid           = "TOKEN_INVALID"
+	errorCodeTokenRevoked           = "TOKEN_REVOKED"
+	errorCodeUserDisabled           = "USER_DISABLED"
+	errorCodeInsufficientPermission = "INSUFFICIENT_PERMISSIONS"
 )
 
 type AuthenticationError struct {
 	StatusCode int
+	Code       string
 	Message    string
 }
 
@@ -35,44 +45,61 @@ func (e *AuthenticationError) Error() string {
 }
 
 // FirebaseAuthenticationFunc は OpenAPI validator 向けの AuthenticationFunc を返します。
-// 認証に成功した場合は検証済みトークンを Gin / request context に格納します。
-func FirebaseAuthenticationFunc(authClient *auth.Client) openapi3filter.AuthenticationFunc {
-	return func(ctx context.Context, _ *openapi3filter.AuthenticationInput) error {
+// 認証に成功した場合は検証済みトークンを Gin / request context に格納し、
+// RolePolicy に従って operation の実行に必要なロールを持つか検証します。
+func FirebaseAuthenticationFunc(authClient TokenVerifier, policy *RolePolicy) openapi3filter.AuthenticationFunc {
+	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
 		ginCtx := ginmiddleware.GetGinContext(ctx)
 		if ginCtx == nil {
 			return &AuthenticationError{
 				StatusCode: http.StatusUnauthorized,
+				Code:       errorCodeAuthenticationFailed,
 				Message:    "Authentication context is unavailable",
 			}
 		}
 
 		token, err := verifyFirebaseToken(ginCtx.GetHeader("Authorization"), ginCtx.Request.Context(), authClient)
 		if err != nil {
-			var authErr *AuthenticationError
-			if errors.As(err, &authErr) {
-				ginCtx.Set(authenticationErrorStatusKey, authErr.StatusCode)
-				ginCtx.Set(authenticationErrorMessageKey, authErr.Message)
-			}
-			return err
+			return recordAuthenticationError(ginCtx, err)
 		}
 
 		setFirebaseToken(ginCtx, token)
+
+		if err := policy.Authorize(operationID(input), token); err != nil {
+			return recordAuthenticationError(ginCtx, err)
+		}
 		return nil
 	}
 }
 
+func recordAuthenticationError(c *gin.Context, err error) error {
+	var authErr *AuthenticationError
+	if errors.As(err, &authErr) {
+		c.Set(authenticationErrorKey, authErr)
+	}
+	return err
+}
+
+func operationID(input *openapi3filter.AuthenticationInput) string {
+	if input == nil || input.RequestValidationInput == nil || input.RequestValidationInput.Route == nil ||
+		input.RequestValidationInput.Route.Operation == nil {
+		return ""
+	}
+	return input.RequestValidationInput.Route.Operation.OperationID
+}
+
 // FirebaseAuth は Authorization: Bearer <Firebase ID Token> を検証する Gin ミドルウェアです。
 // 検証に成功すると、デコードされたトークン（*auth.Token）を context に格納して次のハンドラに渡します。
-func FirebaseAuth(authClient *auth.Client) gin.HandlerFunc {
+func FirebaseAuth(authClient TokenVerifier) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		token, err := verifyFirebaseToken(c.GetHeader("Authorization"), c.Request.Context(), authClient)
 		if err != nil {
 			var authErr *AuthenticationError
 			if errors.As(err, &authErr) {
-				c.AbortWithStatusJSON(authErr.StatusCode, gin.H{"error": authErr.Message})
+				AbortWithAuthenticationError(c, authErr)
 				return
 			}
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authentication failed"})
+			problem.Abort(c, problem.New(http.StatusUnauthorized, "Authentication failed").WithCode(errorCodeAuthenticationFailed))
 			return
 		}
 
@@ -81,10 +108,11 @@ func FirebaseAuth(authClient *auth.Client) gin.HandlerFunc {
 	}
 }
 
-func verifyFirebaseToken(authHeader string, ctx context.Context, authClient *auth.Client) (*auth.Token, error) {
+func verifyFirebaseToken(authHeader string, ctx context.Context, authClient TokenVerifier) (*auth.Token, error) {
 	if authHeader == "" {
 		return nil, &AuthenticationError{
 			StatusCode: http.StatusUnauthorized,
+			Code:       errorCodeAuthenticationRequired,
 			Message:    "Authorization header is required",
 		}
 	}
@@ -93,6 +121,7 @@ func verifyFirebaseToken(authHeader string, ctx context.Context, authClient *aut
 	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
 		return nil, &AuthenticationError{
 			StatusCode: http.StatusUnauthorized,
+			Code:       errorCodeAuthenticationRequired,
 			Message:    "Authorization header must be Bearer <token>",
 		}
 	}
@@ -101,15 +130,21 @@ func verifyFirebaseToken(authHeader string, ctx context.Context, authClient *aut
 	if idToken == "" {
 		return nil, &AuthenticationError{
 			StatusCode: http.StatusUnauthorized,
+			Code:       errorCodeAuthenticationRequired,
 			Message:    "ID token is required",
 		}
 	}
 
 	token, err := authClient.VerifyIDToken(ctx, idToken)
 	if err != nil {
-		status, message := authErrorResponse(err)
+		var authErr *AuthenticationError
+		if errors.As(err, &authErr) {
+			return nil, authErr
+		}
+		status, code, message := authErrorResponse(err)
 		return nil, &AuthenticationError{
 			StatusCode: status,
+			Code:       code,
 			Message:    message,
 		}
 	}
@@ -124,40 +159,35 @@ func setFirebaseToken(c *gin.Context, token *auth.Token) {
 }
 
 // GetAuthenticationError は validator の AuthenticationFunc が格納した認証失敗情報を返します。
-func GetAuthenticationError(c *gin.Context) (int, string, bool) {
-	status, statusExists := c.Get(authenticationErrorStatusKey)
-	message, messageExists := c.Get(authenticationErrorMessageKey)
-	if !statusExists || !messageExists {
-		return 0, "", false
-	}
-
-	statusCode, ok := status.(int)
-	if !ok {
-		return 0, "", false
-	}
-	errorMessage, ok := message.(string)
-	if !ok {
-		return 0, "", false
+func GetAuthenticationError(c *gin.Context) (*AuthenticationError, bool) {
+	val, exists := c.Get(authenticationErrorKey)
+	if !exists {
+		return nil, false
 	}
+	authErr, ok := val.(*AuthenticationError)
+	return authErr, ok
+}
 
-	return statusCode, errorMessage, true
+// AbortWithAuthenticationError は認証失敗を problem+json で返し、リクエストを中断します。
+func AbortWithAuthenticationError(c *gin.Context, authErr *AuthenticationError) {
+	problem.Abort(c, problem.New(authErr.StatusCode, authErr.Message).WithCode(authErr.Code))
 }
 
-// authErrorResponse は Firebase Auth の検証エラーから HTTP ステータスとメッセージを返します。
-func authErrorResponse(err error) (int, string) {
+// authErrorResponse は Firebase Auth の検証エラーから HTTP ステータス、エラーコード、メッセージを返します。
+func authErrorResponse(err error) (int, string, string) {
 	if auth.IsIDTokenExpired(err) {
-		return http.StatusUnauthorized, "ID token has expired"
+		return http.StatusUnauthorized, errorCodeTokenExpired, "ID token has expired"
 	}
 	if auth.IsIDTokenInvalid(err) {
-		return http.StatusUnauthorized, "Invalid ID token"
+		return http.StatusUnauthorized, errorCodeTokenInvalid, "Invalid ID token"
 	}
 	if auth.IsIDTokenRevoked(err) {
-		return http.StatusUnauthorized, "ID token has been revoked"
+		return http.StatusUnauthorized, errorCodeTokenRevoked, "ID token has been revoked"
 	}
 	if auth.IsUserDisabled(err) {
-		return http.StatusForbidden, "User has been disabled"
+		return http.StatusForbidden, errorCodeUserDisabled, "User has been disabled"
 	}
-	return http.StatusUnauthorized, "Authentication failed"
+	return http.StatusUnauthorized, errorCodeAuthenticationFailed, "Authentication failed"
 }
 
 // GetFirebaseToken は Gin の context から検証済みの Firebase トークンを取得します。
@@ -193,26 +223,18 @@ func GetFirebaseTokenFromContext(ctx context.Context) (*auth.Token, bool) {
 }
 
 // RequireAnyClaim は指定したカスタムクレームのいずれかが true であることを検証します。
-// いずれのクレームも満たさない場合は 403 レスポンスを返し false を返します。
+// いずれのクレームも満たさない場合は 403 の problem+json を返し false を返します。
 func RequireAnyClaim(c *gin.Context, claims ...string) bool {
 	token, ok := GetFirebaseToken(c)
 	if !ok {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-			"error": "Authentication required",
-		})
+		problem.Abort(c, problem.New(http.StatusUnauthorized, "Authentication required").WithCode(errorCodeAuthenticationRequired))
 		return false
 	}
 
-	for _, claim := range claims {
-		if val, exists := token.Claims[claim]; exists {
-			if boolVal, ok := val.(bool); ok && boolVal {
-				return true
-			}
-		}
+	if hasAnyClaim(token, claims...) {
+		return true
 	}
 
-	c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
-		"error": "Insufficient permissions",
-	})
+	problem.Abort(c, problem.New(http.StatusForbidden, "Insufficient permissions").WithCode(errorCodeInsufficientPermission))
 	return false
 }
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/internal/metrics"
+)
+
+// Metrics はリクエストごとの件数・レイテンシと処理中のリクエスト数を記録する Gin ミドルウェアです。
+// OpenAPIValidator の前に登録し、operationId と認証失敗の理由を validator の結果から取得します。
+func Metrics(m *metrics.Metrics) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		done := m.RequestStarted()
+		defer done()
+
+		start := time.Now()
+		c.Next()
+
+		m.ObserveRequest(GetOperationID(c), c.Request.Method, c.Writer.Status(), time.Since(start))
+		if authErr, ok := GetAuthenticationError(c); ok {
+			m.ObserveAuthFailure(authErr.Code)
+		}
+	}
+}
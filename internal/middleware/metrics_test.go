@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/internal/metrics"
+)
+
+func TestMetrics_RecordsOperationAndAuthFailure(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	m := metrics.New()
+	router := gin.New()
+	router.Use(Metrics(m), func(c *gin.Context) {
+		c.Set(OperationIDContextKey, "RoomsV1List")
+		if c.GetHeader("Authorization") == "" {
+			_ = recordAuthenticationError(c, &AuthenticationError{
+				StatusCode: http.StatusUnauthorized,
+				Code:       errorCodeAuthenticationRequired,
+			})
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		c.Next()
+	})
+	router.GET("/v1/rooms", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"rooms": []any{}})
+	})
+
+	authorized := httptest.NewRequest(http.MethodGet, "/v1/rooms", nil)
+	authorized.Header.Set("Authorization", "Bearer token")
+	for _, req := range []*http.Request{authorized, httptest.NewRequest(http.MethodGet, "/v1/rooms", nil)} {
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+	for _, want := range []string{
+		`admin_bff_http_requests_total{method="GET",operation="RoomsV1List",status_class="2xx"} 1`,
+		`admin_bff_http_requests_total{method="GET",operation="RoomsV1List",status_class="4xx"} 1`,
+		`admin_bff_auth_failures_total{reason="` + errorCodeAuthenticationRequired + `"} 1`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics output does not contain %q", want)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gin-gonic/gin"
+	ginmiddleware "github.com/oapi-codegen/gin-middleware"
+
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+)
+
+// 検証失敗時に返すエラーコード
+const (
+	errorCodeRouteNotFound    = "ROUTE_NOT_FOUND"
+	errorCodeMethodNotAllowed = "METHOD_NOT_ALLOWED"
+	errorCodeValidationFailed = "VALIDATION_FAILED"
+)
+
+type operationIDKey struct{}
+
+// OperationIDContextKey は Gin の context にリクエストの operationId を格納するキーです。
+var OperationIDContextKey = operationIDKey{}
+
+// OpenAPIValidator はリクエストを OpenAPI 仕様に照らして検証する Gin ミドルウェアを返します。
+// 検証に失敗した場合は、失敗した項目を含む problem+json を返してリクエストを中断します。
+// AuthenticationFunc には ginmiddleware.GetGinContext で Gin の context を渡します。
+func OpenAPIValidator(spec *openapi3.T, options openapi3filter.Options) (gin.HandlerFunc, error) {
+	router, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build OpenAPI router: %w", err)
+	}
+
+	options.MultiError = true
+
+	return func(c *gin.Context) {
+		route, pathParams, err := router.FindRoute(c.Request)
+		if err != nil {
+			switch {
+			case errors.Is(err, routers.ErrPathNotFound):
+				problem.Abort(c, problem.New(http.StatusNotFound, err.Error()).WithCode(errorCodeRouteNotFound))
+			case errors.Is(err, routers.ErrMethodNotAllowed):
+				problem.Abort(c, problem.New(http.StatusMethodNotAllowed, err.Error()).WithCode(errorCodeMethodNotAllowed))
+			default:
+				problem.Abort(c, problem.New(http.StatusBadRequest, err.Error()).WithCode(errorCodeValidationFailed))
+			}
+			return
+		}
+
+		c.Set(OperationIDContextKey, route.Operation.OperationID)
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    c.Request,
+			PathParams: pathParams,
+			Route:      route,
+			Options:    &options,
+		}
+		ctx := context.WithValue(c.Request.Context(), ginmiddleware.GinContextKey, c) //nolint:staticcheck
+
+		if err := openapi3filter.ValidateRequest(ctx, input); err != nil {
+			if authErr, ok := GetAuthenticationError(c); ok {
+				AbortWithAuthenticationError(c, authErr)
+				return
+			}
+			if hasSecurityError(err) {
+				problem.Abort(c, problem.New(http.StatusUnauthorized, "Authentication failed").WithCode(errorCodeAuthenticationFailed))
+				return
+			}
+
+			problem.Abort(c, problem.New(http.StatusBadRequest, "Request validation failed").
+				WithCode(errorCodeValidationFailed).
+				WithErrors(collectFieldErrors(err)))
+			return
+		}
+
+		c.Next()
+	}, nil
+}
+
+// GetOperationID は OpenAPIValidator が格納した operationId を返します。
+// ルートが見つからなかった場合は空文字列を返します。
+func GetOperationID(c *gin.Context) string {
+	return c.GetString(OperationIDContextKey)
+}
+
+func hasSecurityError(err error) bool {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, item := range e {
+			if hasSecurityError(item) {
+				return true
+			}
+		}
+		return false
+	case *openapi3filter.SecurityRequirementsError:
+		return true
+	default:
+		return false
+	}
+}
+
+// collectFieldErrors は検証エラーから失敗した項目の一覧を作成します。
+// MultiError の As は最初の一致しか返さないため、errors.As ではなく型で分岐します。
+func collectFieldErrors(err error) []problem.FieldError {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var fieldErrors []problem.FieldError
+		for _, item := range e {
+			fieldErrors = append(fieldErrors, collectFieldErrors(item)...)
+		}
+		return fieldErrors
+	case *openapi3filter.RequestError:
+		if e.Parameter != nil {
+			return []problem.FieldError{{
+				Field:   e.Parameter.Name,
+				In:      e.Parameter.In,
+				Message: requestErrorMessage(e),
+			}}
+		}
+		switch e.Err.(type) {
+		case openapi3.MultiError, *openapi3.SchemaError:
+			if fieldErrors := collectFieldErrors(e.Err); len(fieldErrors) > 0 {
+				return fieldErrors
+			}
+		}
+		return []problem.FieldError{{Field: "", In: "body", Message: requestErrorMessage(e)}}
+	case *openapi3.SchemaError:
+		return []problem.FieldError{{
+			Field:   "/" + strings.Join(e.JSONPointer(), "/"),
+			In:      "body",
+			Message: e.Reason,
+		}}
+	case *openapi3filter.SecurityRequirementsError:
+		return nil
+	default:
+		return []problem.FieldError{{Field: "", In: "", Message: err.Error()}}
+	}
+}
+
+func requestErrorMessage(err *openapi3filter.RequestError) string {
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err.Err, &schemaErr) && schemaErr.Reason != "" {
+		return schemaErr.Reason
+	}
+	if err.Reason != "" {
+		return err.Reason
+	}
+	if err.Err != nil {
+		return err.Err.Error()
+	}
+	return "invalid value"
+}
@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+)
+
+const validatorTestSpec = `
+openapi: 3.0.3
+info:
+  title: test
+  version: 1.0.0
+paths:
+  /v1/rooms:
+    post:
+      operationId: RoomsV1_create
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              type: object
+              required:
+                - name
+              properties:
+                name:
+                  type: string
+                floor:
+                  type: string
+                  enum:
+                    - Floor1
+      responses:
+        '201':
+          description: created
+`
+
+func newValidatorTestRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(validatorTestSpec))
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+	validator, err := OpenAPIValidator(spec, openapi3filter.Options{})
+	if err != nil {
+		t.Fatalf("new validator: %v", err)
+	}
+
+	router := gin.New()
+	router.Use(validator)
+	router.POST("/v1/rooms", func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+	return router
+}
+
+func TestOpenAPIValidator_ReturnsProblemWithFieldErrors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := newValidatorTestRouter(t)
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v1/rooms?limit=abc", strings.NewReader(`{"floor":"Floor9"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(problem.RequestIDHeader, "req-1")
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+	if got := rec.Header().Get("Content-Type"); !strings.HasPrefix(got, problem.ContentType) {
+		t.Fatalf("content type = %q, want %q", got, problem.ContentType)
+	}
+
+	var body problem.Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	if body.Status != http.StatusBadRequest || body.Instance != "/v1/rooms" || body.RequestID != "req-1" {
+		t.Fatalf("unexpected problem: %+v", body)
+	}
+
+	fields := map[string]string{}
+	for _, e := range body.Errors {
+		fields[e.In+":"+e.Field] = e.Message
+	}
+	for _, want := range []string{"query:limit", "body:/name", "body:/floor"} {
+		if _, ok := fields[want]; !ok {
+			t.Fatalf("field error %q not found in %+v", want, body.Errors)
+		}
+	}
+}
+
+func TestOpenAPIValidator_UnknownRouteIsNotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := newValidatorTestRouter(t)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/unknown", nil))
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
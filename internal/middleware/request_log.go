@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/internal/logging"
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+)
+
+// maxRequestIDLength は受け付ける X-Request-ID の最大長です。
+const maxRequestIDLength = 128
+
+// RequestID は X-Request-ID を受け取り、なければ生成する Gin ミドルウェアです。
+// リクエスト ID はレスポンスヘッダーと request context に格納され、上流 API への呼び出しにも引き継がれます。
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(problem.RequestIDHeader)
+		if !isValidRequestID(requestID) {
+			requestID = newRequestID()
+		}
+
+		c.Header(problem.RequestIDHeader, requestID)
+		c.Request = c.Request.WithContext(logging.WithRequestID(c.Request.Context(), requestID))
+		c.Next()
+	}
+}
+
+// isValidRequestID はヘッダーにそのまま書き戻せる ID かを判定します。
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// RequestLogger はリクエストごとに Cloud Logging の httpRequest 形式でアクセスログを出力する Gin ミドルウェアです。
+// 5xx は ERROR、4xx は WARNING、それ以外は INFO として出力します。
+func RequestLogger(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+		latency := time.Since(start)
+
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+
+		attrs := []slog.Attr{
+			slog.Group("httpRequest",
+				slog.String("requestMethod", c.Request.Method),
+				slog.String("requestUrl", c.Request.URL.RequestURI()),
+				slog.Int("status", status),
+				slog.Int("responseSize", max(c.Writer.Size(), 0)),
+				slog.String("userAgent", c.Request.UserAgent()),
+				slog.String("remoteIp", c.ClientIP()),
+				slog.String("latency", fmt.Sprintf("%.9fs", latency.Seconds())),
+			),
+		}
+		if operationID := GetOperationID(c); operationID != "" {
+			attrs = append(attrs, slog.String("operationId", operationID))
+		}
+		if uid := GetFirebaseUID(c); uid != "" {
+			attrs = append(attrs, slog.String("actorUid", uid))
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, slog.String("error", c.Errors.String()))
+		}
+
+		logger.LogAttrs(c.Request.Context(), level, "request completed", attrs...)
+	}
+}
+
+// Recovery はパニックを ERROR ログとして記録し、500 の problem+json を返す Gin ミドルウェアです。
+func Recovery(logger *slog.Logger) gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(nil, func(c *gin.Context, recovered any) {
+		logger.ErrorContext(c.Request.Context(), "panic recovered",
+			slog.Any("panic", recovered),
+			slog.String("path", c.Request.URL.Path),
+			slog.String("stack", string(debug.Stack())),
+		)
+		problem.Abort(c, problem.New(http.StatusInternalServerError, "internal server error"))
+	})
+}
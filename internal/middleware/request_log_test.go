@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/fun-dotto/admin-bff-api/internal/logging"
+	"github.com/fun-dotto/admin-bff-api/internal/problem"
+)
+
+func TestRequestID_AcceptsOrGeneratesID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var gotContextID string
+	router := gin.New()
+	router.Use(RequestID())
+	router.GET("/", func(c *gin.Context) {
+		gotContextID = logging.RequestIDFromContext(c.Request.Context())
+		c.Status(http.StatusNoContent)
+	})
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(problem.RequestIDHeader, "client-supplied-id")
+	router.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get(problem.RequestIDHeader); got != "client-supplied-id" || gotContextID != got {
+		t.Fatalf("request id header = %q, context = %q", got, gotContextID)
+	}
+
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(problem.RequestIDHeader, "bad id\twith spaces")
+	router.ServeHTTP(rec, req)
+
+	got := rec.Header().Get(problem.RequestIDHeader)
+	if got == "" || got == "bad id\twith spaces" || gotContextID != got {
+		t.Fatalf("request id header = %q, context = %q", got, gotContextID)
+	}
+}
@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"firebase.google.com/go/v4/auth"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// RequiredRolesExtension は operation ごとに必要なロールを宣言する OpenAPI 拡張です。
+// いずれかのロールのカスタムクレームが true であればアクセスを許可します。
+const RequiredRolesExtension = "x-required-roles"
+
+// RolePolicy は operationId ごとに必要なロールを保持します。
+// 登録されていない operationId へのアクセスは拒否します。
+type RolePolicy struct {
+	roles map[string][]string
+}
+
+// NewRolePolicy は operationId とロールの対応からポリシーを作成します。
+func NewRolePolicy(roles map[string][]string) *RolePolicy {
+	copied := make(map[string][]string, len(roles))
+	for operationID, r := range roles {
+		copied[operationID] = slices.Clone(r)
+	}
+	return &RolePolicy{roles: copied}
+}
+
+// NewRolePolicyFromSpec は OpenAPI 仕様の x-required-roles からポリシーを作成します。
+// 拡張の値がロール名の配列でない場合はエラーを返します。
+func NewRolePolicyFromSpec(spec *openapi3.T) (*RolePolicy, error) {
+	roles := make(map[string][]string)
+	for path, pathItem := range spec.Paths.Map() {
+		for method, operation := range pathItem.Operations() {
+			value, ok := operation.Extensions[RequiredRolesExtension]
+			if !ok {
+				continue
+			}
+			if operation.OperationID == "" {
+				return nil, fmt.Errorf("%s %s: %s requires operationId", method, path, RequiredRolesExtension)
+			}
+			operationRoles, err := parseRoles(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", operation.OperationID, err)
+			}
+			roles[operation.OperationID] = operationRoles
+		}
+	}
+	return &RolePolicy{roles: roles}, nil
+}
+
+func parseRoles(value any) ([]string, error) {
+	items, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an array of role names", RequiredRolesExtension)
+	}
+	roles := make([]string, 0, len(items))
+	for _, item := range items {
+		role, ok := item.(string)
+		if !ok || role == "" {
+			return nil, fmt.Errorf("%s must be an array of role names", RequiredRolesExtension)
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+// Restrict は allowed に含まれるロールのみを残したポリシーを返します。
+// 許可されたロールが残らない operationId へのアクセスは全て拒否します。
+func (p *RolePolicy) Restrict(allowed []string) *RolePolicy {
+	roles := make(map[string][]string, len(p.roles))
+	for operationID, operationRoles := range p.roles {
+		kept := make([]string, 0, len(operationRoles))
+		for _, role := range operationRoles {
+			if slices.Contains(allowed, role) {
+				kept = append(kept, role)
+			}
+		}
+		roles[operationID] = kept
+	}
+	return &RolePolicy{roles: roles}
+}
+
+// Roles は operationId に必要なロールを返します。登録されていない場合は false を返します。
+func (p *RolePolicy) Roles(operationID string) ([]string, bool) {
+	roles, ok := p.roles[operationID]
+	return roles, ok
+}
+
+// Authorize はトークンが operationId の実行に必要なロールを持つか検証します。
+// 登録されていない operationId、またはロールを満たさない場合は 403 の AuthenticationError を返します。
+func (p *RolePolicy) Authorize(operationID string, token *auth.Token) error {
+	roles, ok := p.Roles(operationID)
+	if ok && hasAnyClaim(token, roles...) {
+		return nil
+	}
+	return &AuthenticationError{
+		StatusCode: http.StatusForbidden,
+		Code:       errorCodeInsufficientPermission,
+		Message:    "Insufficient permissions",
+	}
+}
+
+// hasAnyClaim は指定したカスタムクレームのいずれかが true であるかを返します。
+func hasAnyClaim(token *auth.Token, claims ...string) bool {
+	if token == nil {
+		return false
+	}
+	for _, claim := range claims {
+		if val, exists := token.Claims[claim]; exists {
+			if boolVal, ok := val.(bool); ok && boolVal {
+				return true
+			}
+		}
+	}
+	return false
+}
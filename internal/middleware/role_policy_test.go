@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+
+	"firebase.google.com/go/v4/auth"
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/fun-dotto/admin-bff-api/openapi"
+)
+
+func TestRolePolicy_Authorize(t *testing.T) {
+	policy := NewRolePolicy(map[string][]string{
+		"MenuItemsV1_list": {"admin", "cafeteria"},
+		"UsersV1_list":     {"admin"},
+	})
+	cafeteria := &auth.Token{Claims: map[string]interface{}{"cafeteria": true}}
+
+	if err := policy.Authorize("MenuItemsV1_list", cafeteria); err != nil {
+		t.Fatalf("MenuItemsV1_list: unexpected error: %v", err)
+	}
+
+	for _, operationID := range []string{"UsersV1_list", "Unmapped_operation"} {
+		err := policy.Authorize(operationID, cafeteria)
+		var authErr *AuthenticationError
+		if !errors.As(err, &authErr) || authErr.StatusCode != http.StatusForbidden {
+			t.Fatalf("%s: error = %v, want 403 AuthenticationError", operationID, err)
+		}
+	}
+}
+
+func TestNewRolePolicyFromSpec_EverySecuredOperationDeclaresRoles(t *testing.T) {
+	spec, err := openapi3.NewLoader().LoadFromData(openapi.Spec)
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+
+	policy, err := NewRolePolicyFromSpec(spec)
+	if err != nil {
+		t.Fatalf("new role policy: %v", err)
+	}
+
+	for path, pathItem := range spec.Paths.Map() {
+		for method, operation := range pathItem.Operations() {
+			security := spec.Security
+			if operation.Security != nil {
+				security = *operation.Security
+			}
+			if len(security) == 0 {
+				continue
+			}
+			if _, ok := policy.Roles(operation.OperationID); !ok {
+				t.Errorf("%s %s (%s) has no %s", method, path, operation.OperationID, RequiredRolesExtension)
+			}
+		}
+	}
+}
+
+func TestNewRolePolicyFromSpec_RejectsMalformedRoles(t *testing.T) {
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(`
+openapi: 3.0.3
+info:
+  title: test
+  version: 1.0.0
+paths:
+  /v1/rooms:
+    get:
+      operationId: RoomsV1_list
+      x-required-roles: admin
+      responses:
+        '200':
+          description: ok
+`))
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+
+	if _, err := NewRolePolicyFromSpec(spec); err == nil {
+		t.Fatal("expected error for non-array x-required-roles")
+	}
+}
+
+func TestRolePolicy_Restrict(t *testing.T) {
+	policy := NewRolePolicy(map[string][]string{
+		"AnnouncementsV1List": {"admin", "developer"},
+		"MenuItemsV1List":     {"cafeteria"},
+	}).Restrict([]string{"admin"})
+
+	if roles, _ := policy.Roles("AnnouncementsV1List"); !slices.Equal(roles, []string{"admin"}) {
+		t.Fatalf("roles = %v, want [admin]", roles)
+	}
+	if err := policy.Authorize("AnnouncementsV1List", &auth.Token{Claims: map[string]interface{}{"developer": true}}); err == nil {
+		t.Fatal("expected developer to be denied")
+	}
+	if err := policy.Authorize("MenuItemsV1List", &auth.Token{Claims: map[string]interface{}{"cafeteria": true}}); err == nil {
+		t.Fatal("expected cafeteria to be denied")
+	}
+}
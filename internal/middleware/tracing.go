@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/fun-dotto/admin-bff-api/internal/logging"
+	"github.com/fun-dotto/admin-bff-api/internal/telemetry"
+)
+
+// Tracing はリクエストごとにサーバースパンを作成する Gin ミドルウェアです。
+// 受信した W3C Trace Context を親とし、スパン名は OpenAPIValidator が特定した operationId にします。
+// スパンは request context に格納されるため、上流 API への呼び出しは子スパンになります。
+// TracerProvider と Propagator はグローバルに設定されたものを使用します。
+func Tracing() gin.HandlerFunc {
+	tracer := otel.Tracer(telemetry.TracerName)
+
+	return func(c *gin.Context) {
+		ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+
+		ctx, span := tracer.Start(ctx, c.Request.Method+" "+routeOf(c),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(c.Request.Method),
+				semconv.URLPath(c.Request.URL.Path),
+				semconv.HTTPRoute(routeOf(c)),
+			),
+		)
+		defer span.End()
+
+		if requestID := logging.RequestIDFromContext(ctx); requestID != "" {
+			span.SetAttributes(attribute.String("request.id", requestID))
+		}
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		if operationID := GetOperationID(c); operationID != "" {
+			span.SetName(operationID)
+			span.SetAttributes(attribute.String("openapi.operation_id", operationID))
+		}
+
+		status := c.Writer.Status()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
+
+func routeOf(c *gin.Context) string {
+	if route := c.FullPath(); route != "" {
+		return route
+	}
+	return c.Request.URL.Path
+}
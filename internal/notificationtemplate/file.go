@@ -0,0 +1,106 @@
+package notificationtemplate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+)
+
+// FileStore はテンプレートを JSON ファイルに保存する Store です。
+// 変更のたびにファイル全体を書き直すため、少数のテンプレートを 1 プロセスから扱う用途を想定しています。
+type FileStore struct {
+	*MemoryStore
+	path string
+}
+
+var _ Store = (*FileStore)(nil)
+
+// OpenFileStore は path のファイルからテンプレートを読み込んだ FileStore を作成します。
+// ファイルが存在しない場合は空の状態で開始し、最初の変更時に作成します。
+func OpenFileStore(path string) (*FileStore, error) {
+	store := &FileStore{MemoryStore: NewMemoryStore(), path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notification templates: %w", err)
+	}
+	var templates []Template
+	if err := json.Unmarshal(data, &templates); err != nil {
+		return nil, fmt.Errorf("failed to parse notification templates %s: %w", path, err)
+	}
+	for _, template := range templates {
+		store.templates[template.ID] = template
+	}
+	return store, nil
+}
+
+// Create はテンプレートを保存してファイルに書き込みます。
+func (s *FileStore) Create(_ context.Context, template Template) error {
+	return s.modify(func() error { return s.put(template, false) })
+}
+
+// Update はテンプレートを置き換えてファイルに書き込みます。
+func (s *FileStore) Update(_ context.Context, template Template) error {
+	return s.modify(func() error { return s.put(template, true) })
+}
+
+// Delete はテンプレートを削除してファイルに書き込みます。
+func (s *FileStore) Delete(_ context.Context, id string) error {
+	return s.modify(func() error {
+		if _, ok := s.templates[id]; !ok {
+			return ErrNotFound
+		}
+		delete(s.templates, id)
+		return nil
+	})
+}
+
+// modify はロックを取得して change を適用し、ファイルに書き込みます。
+// 書き込みに失敗した場合はメモリ上の変更も取り消します。
+func (s *FileStore) modify(change func() error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous := maps.Clone(s.templates)
+	if err := change(); err != nil {
+		return err
+	}
+	if err := s.write(); err != nil {
+		s.templates = previous
+		return err
+	}
+	return nil
+}
+
+// write は一時ファイルに書き込んでから置き換え、書き込み途中のファイルが読まれないようにします。
+func (s *FileStore) write() error {
+	data, err := json.MarshalIndent(s.list(), "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write notification templates: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write notification templates: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write notification templates: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to write notification templates: %w", err)
+	}
+	return nil
+}
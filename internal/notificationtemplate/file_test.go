@@ -0,0 +1,51 @@
+package notificationtemplate
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "templates.json")
+
+	store, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore() error = %v", err)
+	}
+	if err := store.Create(ctx, Template{ID: "t1", Name: "休講", Title: "{subject}", Body: "b"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := store.Create(ctx, Template{ID: "t2", Name: "休講", Title: "t", Body: "b"}); !errors.Is(err, ErrNameConflict) {
+		t.Fatalf("Create() with duplicate name error = %v", err)
+	}
+	if err := store.Create(ctx, Template{ID: "t2", Name: "補講", Title: "t", Body: "b"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := store.Update(ctx, Template{ID: "t2", Name: "休講", Title: "t", Body: "b"}); !errors.Is(err, ErrNameConflict) {
+		t.Fatalf("Update() with duplicate name error = %v", err)
+	}
+	if err := store.Update(ctx, Template{ID: "missing", Name: "x"}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Update() of missing template error = %v", err)
+	}
+	if err := store.Delete(ctx, "t2"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	reopened, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("reopen error = %v", err)
+	}
+	templates, err := reopened.List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(templates) != 1 || templates[0].ID != "t1" || templates[0].Title != "{subject}" {
+		t.Fatalf("templates after reopen = %+v", templates)
+	}
+	if _, err := reopened.Get(ctx, "t2"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get() of deleted template error = %v", err)
+	}
+}
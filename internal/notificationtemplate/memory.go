@@ -0,0 +1,92 @@
+package notificationtemplate
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// MemoryStore はテンプレートをメモリ上に保持する Store です。
+// プロセスを再起動するとテンプレートは失われます。
+type MemoryStore struct {
+	mu        sync.RWMutex
+	templates map[string]Template
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore は空の MemoryStore を作成します。
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{templates: map[string]Template{}}
+}
+
+// List は全てのテンプレートを名前順に返します。
+func (s *MemoryStore) List(_ context.Context) ([]Template, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list(), nil
+}
+
+// Get は ID のテンプレートを返します。
+func (s *MemoryStore) Get(_ context.Context, id string) (Template, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	template, ok := s.templates[id]
+	if !ok {
+		return Template{}, ErrNotFound
+	}
+	return template, nil
+}
+
+// Create はテンプレートを保存します。
+func (s *MemoryStore) Create(_ context.Context, template Template) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.put(template, false)
+}
+
+// Update は ID のテンプレートを置き換えます。
+func (s *MemoryStore) Update(_ context.Context, template Template) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.put(template, true)
+}
+
+// Delete は ID のテンプレートを削除します。
+func (s *MemoryStore) Delete(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.templates[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.templates, id)
+	return nil
+}
+
+func (s *MemoryStore) list() []Template {
+	return slices.SortedFunc(maps.Values(s.templates), func(a, b Template) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.ID, b.ID))
+	})
+}
+
+// put は呼び出し元でロックを取得した状態でテンプレートを保存します。
+// replace が true の場合は既存のテンプレートの置き換えのみを許可します。
+func (s *MemoryStore) put(template Template, replace bool) error {
+	if _, ok := s.templates[template.ID]; ok != replace {
+		if replace {
+			return ErrNotFound
+		}
+		return ErrNameConflict
+	}
+	for id, existing := range s.templates {
+		if id != template.ID && existing.Name == template.Name {
+			return ErrNameConflict
+		}
+	}
+	s.templates[template.ID] = template
+	return nil
+}
@@ -0,0 +1,132 @@
+package notificationtemplate
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotFound は指定した ID のテンプレートが存在しないことを表します。
+	ErrNotFound = errors.New("notification template not found")
+	// ErrNameConflict は同じ名前のテンプレートが既に存在することを表します。
+	ErrNameConflict = errors.New("notification template with the same name already exists")
+)
+
+// placeholderPattern は {subject} のようなプレースホルダーに一致します。
+var placeholderPattern = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// Template は通知の文面と配信オプションを再利用するためのテンプレートです。
+// 文字列の項目には {name} 形式のプレースホルダーを含めることができます。
+type Template struct {
+	ID                   string    `json:"id"`
+	Name                 string    `json:"name"`
+	Description          *string   `json:"description,omitempty"`
+	Title                string    `json:"title"`
+	Body                 string    `json:"body"`
+	ImageURL             *string   `json:"imageUrl,omitempty"`
+	URL                  *string   `json:"url,omitempty"`
+	WebpushLink          *string   `json:"webpushLink,omitempty"`
+	AnalyticsLabel       *string   `json:"analyticsLabel,omitempty"`
+	ApnsBadge            *int      `json:"apnsBadge,omitempty"`
+	ApnsSound            *string   `json:"apnsSound,omitempty"`
+	ApnsContentAvailable *bool     `json:"apnsContentAvailable,omitempty"`
+	AndroidChannelID     *string   `json:"androidChannelId,omitempty"`
+	AndroidPriority      *string   `json:"androidPriority,omitempty"`
+	AndroidTTLSeconds    *int      `json:"androidTtlSeconds,omitempty"`
+	CreatedAt            time.Time `json:"createdAt"`
+	UpdatedAt            time.Time `json:"updatedAt"`
+}
+
+// Store はテンプレートの保存先です。
+type Store interface {
+	// List は全てのテンプレートを名前順に返します。
+	List(ctx context.Context) ([]Template, error)
+	// Get は ID のテンプレートを返します。存在しない場合は ErrNotFound を返します。
+	Get(ctx context.Context, id string) (Template, error)
+	// Create はテンプレートを保存します。同じ名前のテンプレートがある場合は ErrNameConflict を返します。
+	Create(ctx context.Context, template Template) error
+	// Update は ID のテンプレートを置き換えます。存在しない場合は ErrNotFound を返します。
+	Update(ctx context.Context, template Template) error
+	// Delete は ID のテンプレートを削除します。存在しない場合は ErrNotFound を返します。
+	Delete(ctx context.Context, id string) error
+}
+
+// NewID はテンプレートの ID を生成します。
+func NewID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Placeholders はテンプレートに含まれるプレースホルダーの名前を昇順で返します。
+func (t Template) Placeholders() []string {
+	texts := []string{t.Title, t.Body}
+	for _, field := range t.optionalTextFields() {
+		if *field != nil {
+			texts = append(texts, **field)
+		}
+	}
+
+	var names []string
+	for _, text := range texts {
+		for _, match := range placeholderPattern.FindAllStringSubmatch(text, -1) {
+			names = append(names, match[1])
+		}
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// MissingVariablesError はプレースホルダーに対応する変数が指定されていないことを表します。
+type MissingVariablesError struct {
+	Names []string
+}
+
+func (e *MissingVariablesError) Error() string {
+	return fmt.Sprintf("missing template variables: %s", strings.Join(e.Names, ", "))
+}
+
+// Render はプレースホルダーを variables の値で置き換えたテンプレートを返します。
+// 全てのプレースホルダーに対応する変数が必要で、不足している場合は *MissingVariablesError を返します。
+func (t Template) Render(variables map[string]string) (Template, error) {
+	var missing []string
+	for _, name := range t.Placeholders() {
+		if _, ok := variables[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return Template{}, &MissingVariablesError{Names: missing}
+	}
+
+	replace := func(text string) string {
+		return placeholderPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+			return variables[placeholder[1:len(placeholder)-1]]
+		})
+	}
+	rendered := t
+	rendered.Title = replace(t.Title)
+	rendered.Body = replace(t.Body)
+	for _, field := range rendered.optionalTextFields() {
+		if *field != nil {
+			value := replace(**field)
+			*field = &value
+		}
+	}
+	return rendered, nil
+}
+
+// optionalTextFields は title と body 以外でプレースホルダーを含められる項目を返します。
+func (t *Template) optionalTextFields() []**string {
+	return []**string{
+		&t.ImageURL, &t.URL, &t.WebpushLink, &t.AnalyticsLabel,
+		&t.ApnsSound, &t.AndroidChannelID, &t.AndroidPriority,
+	}
+}
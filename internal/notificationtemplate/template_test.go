@@ -0,0 +1,42 @@
+package notificationtemplate
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestTemplateRender(t *testing.T) {
+	url := "https://example.com/subjects/{subjectId}"
+	sound := "default"
+	template := Template{
+		Title:     "{subject} は休講です",
+		Body:      "{date} {period} の {subject} は休講です",
+		URL:       &url,
+		ApnsSound: &sound,
+	}
+
+	if got := template.Placeholders(); !slices.Equal(got, []string{"date", "period", "subject", "subjectId"}) {
+		t.Fatalf("Placeholders() = %v", got)
+	}
+
+	_, err := template.Render(map[string]string{"subject": "解析学", "date": "4/1"})
+	var missing *MissingVariablesError
+	if !errors.As(err, &missing) || !slices.Equal(missing.Names, []string{"period", "subjectId"}) {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	rendered, err := template.Render(map[string]string{"subject": "解析学", "date": "4/1", "period": "1限", "subjectId": "s1", "unused": "x"})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if rendered.Title != "解析学 は休講です" || rendered.Body != "4/1 1限 の 解析学 は休講です" {
+		t.Fatalf("rendered = %q / %q", rendered.Title, rendered.Body)
+	}
+	if *rendered.URL != "https://example.com/subjects/s1" || *rendered.ApnsSound != "default" {
+		t.Fatalf("rendered url = %q, sound = %q", *rendered.URL, *rendered.ApnsSound)
+	}
+	if *template.URL != url {
+		t.Fatal("Render must not modify the template")
+	}
+}
@@ -0,0 +1,92 @@
+package problem
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ContentType は RFC 7807 の Problem Details を返す際の Content-Type です。
+const ContentType = "application/problem+json"
+
+// RequestIDHeader はリクエストを識別する ID を受け渡すヘッダー名です。
+const RequestIDHeader = "X-Request-ID"
+
+// DefaultType は type を個別に定義しない場合に用いる RFC 7807 の既定値です。
+const DefaultType = "about:blank"
+
+// FieldError はリクエストの検証に失敗した項目を表します。
+type FieldError struct {
+	// Field は検証に失敗した項目名です。リクエストボディの場合は JSON Pointer 形式です。
+	Field string `json:"field"`
+	// In は項目の位置です（path / query / header / body / template）。
+	In      string `json:"in"`
+	Message string `json:"message"`
+}
+
+// Problem は RFC 7807 の Problem Details です。
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Instance  string       `json:"instance,omitempty"`
+	RequestID string       `json:"requestId,omitempty"`
+	Code      string       `json:"code,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
+}
+
+// New はステータスコードと詳細メッセージから Problem を作成します。
+func New(status int, detail string) *Problem {
+	return &Problem{
+		Type:   DefaultType,
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	}
+}
+
+// WithCode は機械判読可能なエラーコードを設定します。
+func (p *Problem) WithCode(code string) *Problem {
+	p.Code = code
+	return p
+}
+
+// WithErrors は検証に失敗した項目を設定します。
+func (p *Problem) WithErrors(errs []FieldError) *Problem {
+	p.Errors = errs
+	return p
+}
+
+// Respond は instance と requestId を補完して Problem をレスポンスに書き込みます。
+func Respond(c *gin.Context, p *Problem) {
+	complete(c, p)
+	c.Header("Content-Type", ContentType)
+	c.JSON(p.Status, p)
+}
+
+// Abort は Problem をレスポンスに書き込み、以降のハンドラの実行を中断します。
+func Abort(c *gin.Context, p *Problem) {
+	complete(c, p)
+	c.Header("Content-Type", ContentType)
+	c.AbortWithStatusJSON(p.Status, p)
+}
+
+func complete(c *gin.Context, p *Problem) {
+	if p.Instance == "" && c.Request != nil && c.Request.URL != nil {
+		p.Instance = c.Request.URL.Path
+	}
+	if p.RequestID == "" {
+		p.RequestID = requestID(c)
+	}
+}
+
+func requestID(c *gin.Context) string {
+	if id := c.Writer.Header().Get(RequestIDHeader); id != "" {
+		return id
+	}
+	if c.Request != nil {
+		return c.GetHeader(RequestIDHeader)
+	}
+	return ""
+}
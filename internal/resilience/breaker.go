@@ -0,0 +1,142 @@
+package resilience
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// State はサーキットブレーカーの状態です。
+type State int
+
+const (
+	// StateClosed は通常どおりリクエストを送信する状態です。
+	StateClosed State = iota
+	// StateHalfOpen は OpenDuration の経過後、1 件だけ試行して回復を確認する状態です。
+	StateHalfOpen
+	// StateOpen は上流への送信を止めて即座に失敗させる状態です。
+	StateOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half-open"
+	case StateOpen:
+		return "open"
+	default:
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+}
+
+// CircuitOpenError はサーキットブレーカーが開いているためリクエストを送信しなかったことを表します。
+type CircuitOpenError struct {
+	Service string
+	// RetryAfter は次に試行できるまでの時間です。
+	RetryAfter time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker for %s is open", e.Service)
+}
+
+// Breaker は連続した失敗の回数で開閉するサーキットブレーカーです。
+type Breaker struct {
+	service          string
+	failureThreshold int
+	openDuration     time.Duration
+	onStateChange    func(State)
+	now              func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewBreaker は failureThreshold 回連続で失敗すると openDuration の間開くブレーカーを作成します。
+// onStateChange は状態が変わるたびに呼び出されます。nil の場合は呼び出しません。
+func NewBreaker(service string, failureThreshold int, openDuration time.Duration, onStateChange func(State)) *Breaker {
+	if onStateChange == nil {
+		onStateChange = func(State) {}
+	}
+	return &Breaker{
+		service:          service,
+		failureThreshold: failureThreshold,
+		openDuration:     openDuration,
+		onStateChange:    onStateChange,
+		now:              time.Now,
+	}
+}
+
+// State は現在の状態を返します。
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Allow はリクエストを送信してよいかを返します。
+// 送信した場合は結果を Record で報告してください。
+func (b *Breaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		remaining := b.openDuration - b.now().Sub(b.openedAt)
+		if remaining > 0 {
+			return &CircuitOpenError{Service: b.service, RetryAfter: remaining}
+		}
+		b.setState(StateHalfOpen)
+		b.probing = true
+		return nil
+	case StateHalfOpen:
+		if b.probing {
+			return &CircuitOpenError{Service: b.service, RetryAfter: b.openDuration}
+		}
+		b.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// Record は Allow で許可したリクエストの結果を記録します。
+func (b *Breaker) Record(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if success {
+		b.failures = 0
+		b.probing = false
+		b.setState(StateClosed)
+		return
+	}
+
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.failureThreshold {
+		b.probing = false
+		b.openedAt = b.now()
+		b.setState(StateOpen)
+	}
+}
+
+// Abandon は Allow で許可したリクエストが結果を判断できないまま終わったことを記録します。
+// 状態は変えずに、半開状態の試行枠だけを解放します。
+func (b *Breaker) Abandon() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *Breaker) setState(state State) {
+	if b.state == state {
+		return
+	}
+	b.state = state
+	b.onStateChange(state)
+}
@@ -0,0 +1,70 @@
+package resilience
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBreaker(t *testing.T) {
+	now := time.Unix(0, 0)
+	var states []State
+	b := NewBreaker("academic", 2, 10*time.Second, func(s State) { states = append(states, s) })
+	b.now = func() time.Time { return now }
+
+	for range 2 {
+		if err := b.Allow(); err != nil {
+			t.Fatalf("Allow() error = %v while closed", err)
+		}
+		b.Record(false)
+	}
+	if b.State() != StateOpen {
+		t.Fatalf("state = %v, want open", b.State())
+	}
+
+	now = now.Add(4 * time.Second)
+	var openErr *CircuitOpenError
+	if err := b.Allow(); !errors.As(err, &openErr) || openErr.RetryAfter != 6*time.Second {
+		t.Fatalf("Allow() error = %v, want CircuitOpenError with RetryAfter 6s", err)
+	}
+
+	now = now.Add(6 * time.Second)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("Allow() error = %v after open duration", err)
+	}
+	if err := b.Allow(); !errors.As(err, &openErr) {
+		t.Fatalf("Allow() error = %v, want only one half-open probe", err)
+	}
+	b.Record(true)
+
+	if b.State() != StateClosed {
+		t.Fatalf("state = %v, want closed", b.State())
+	}
+	want := []State{StateOpen, StateHalfOpen, StateClosed}
+	if len(states) != len(want) {
+		t.Fatalf("state changes = %v, want %v", states, want)
+	}
+	for i := range want {
+		if states[i] != want[i] {
+			t.Fatalf("state changes = %v, want %v", states, want)
+		}
+	}
+}
+
+func TestBreaker_FailedProbeReopens(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := NewBreaker("user", 1, time.Second, nil)
+	b.now = func() time.Time { return now }
+
+	_ = b.Allow()
+	b.Record(false)
+	now = now.Add(time.Second)
+	if err := b.Allow(); err != nil {
+		t.Fatalf("Allow() error = %v", err)
+	}
+	b.Record(false)
+
+	if b.State() != StateOpen {
+		t.Fatalf("state = %v, want open", b.State())
+	}
+}
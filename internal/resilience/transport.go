@@ -0,0 +1,149 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// maxBackoff は再試行の待ち時間の上限です。
+const maxBackoff = 5 * time.Second
+
+// RetryPolicy は冪等なリクエストの再試行設定です。
+type RetryPolicy struct {
+	// MaxAttempts は最初の送信を含む試行回数の上限です。1 の場合は再試行しません。
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	// OnRetry は再試行のたびに呼び出されます。nil の場合は呼び出しません。
+	OnRetry func()
+}
+
+// Transport は上流 API 呼び出しを再試行し、サーキットブレーカーで保護する RoundTripper です。
+// 試行ごとにブレーカーを通すため、ブレーカーが開くと残りの再試行も即座に打ち切ります。
+type Transport struct {
+	base    http.RoundTripper
+	breaker *Breaker
+	retry   RetryPolicy
+	sleep   func(context.Context, time.Duration) error
+}
+
+// NewTransport は base を再試行とサーキットブレーカーで包みます。
+func NewTransport(base http.RoundTripper, breaker *Breaker, retry RetryPolicy) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if retry.MaxAttempts < 1 {
+		retry.MaxAttempts = 1
+	}
+	return &Transport{base: base, breaker: breaker, retry: retry, sleep: sleepContext}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attempts := 1
+	if isIdempotent(req.Method) && (req.Body == nil || req.Body == http.NoBody || req.GetBody != nil) {
+		attempts = t.retry.MaxAttempts
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if err := t.sleep(req.Context(), backoff(t.retry.InitialBackoff, attempt)); err != nil {
+				return nil, err
+			}
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				req = req.Clone(req.Context())
+				req.Body = body
+			}
+			if t.retry.OnRetry != nil {
+				t.retry.OnRetry()
+			}
+		}
+
+		resp, err := t.roundTrip(req)
+		if attempt+1 >= attempts || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+		if resp != nil {
+			// 再試行する前に接続を再利用できるようボディを読み捨てる
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+			resp.Body.Close()
+		}
+	}
+}
+
+func (t *Transport) roundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.breaker.Allow(); err != nil {
+		return nil, err
+	}
+	resp, err := t.base.RoundTrip(req)
+	switch {
+	case err != nil:
+		// 呼び出し元が中断したリクエストは上流の障害として数えない。タイムアウトは障害として数える
+		if errors.Is(req.Context().Err(), context.Canceled) {
+			t.breaker.Abandon()
+		} else {
+			t.breaker.Record(false)
+		}
+	case resp.StatusCode >= http.StatusInternalServerError:
+		t.breaker.Record(false)
+	default:
+		t.breaker.Record(true)
+	}
+	return resp, err
+}
+
+// isIdempotent は再試行してよいメソッドかどうかを返します。
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// shouldRetry は一時的な失敗とみなして再試行するかを返します。
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		var openErr *CircuitOpenError
+		return !errors.As(err, &openErr)
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff は attempt 回目の再試行までの待ち時間を full jitter で返します。
+func backoff(initial time.Duration, attempt int) time.Duration {
+	if initial <= 0 {
+		return 0
+	}
+	ceiling := initial << (attempt - 1)
+	if ceiling <= 0 || ceiling > maxBackoff {
+		ceiling = maxBackoff
+	}
+	return rand.N(ceiling) + 1
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
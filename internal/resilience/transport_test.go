@@ -0,0 +1,103 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestTransport(base http.RoundTripper, threshold, maxAttempts int) (*Transport, *atomic.Int32) {
+	var retries atomic.Int32
+	tr := NewTransport(base, NewBreaker("academic", threshold, time.Minute, nil), RetryPolicy{
+		MaxAttempts:    maxAttempts,
+		InitialBackoff: time.Millisecond,
+		OnRetry:        func() { retries.Add(1) },
+	})
+	tr.sleep = func(context.Context, time.Duration) error { return nil }
+	return tr, &retries
+}
+
+func TestTransport_RetriesIdempotentRequests(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	tr, retries := newTestTransport(http.DefaultTransport, 10, 3)
+	req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"name":"363"}`))
+
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK || string(body) != `{"name":"363"}` {
+		t.Fatalf("response = %d %s, want 200 with replayed body", resp.StatusCode, body)
+	}
+	if calls.Load() != 3 || retries.Load() != 2 {
+		t.Fatalf("calls = %d, retries = %d; want 3 and 2", calls.Load(), retries.Load())
+	}
+}
+
+func TestTransport_DoesNotRetryPost(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	tr, _ := newTestTransport(http.DefaultTransport, 10, 3)
+	req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{}`))
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if calls.Load() != 1 {
+		t.Fatalf("calls = %d, want 1", calls.Load())
+	}
+}
+
+func TestTransport_FailsFastWhenCircuitOpens(t *testing.T) {
+	var calls atomic.Int32
+	base := roundTripFunc(func(*http.Request) (*http.Response, error) {
+		calls.Add(1)
+		return nil, errors.New("connection reset by peer")
+	})
+
+	tr, _ := newTestTransport(base, 2, 5)
+	req, _ := http.NewRequest(http.MethodGet, "http://academic.internal/v1/rooms", nil)
+
+	var openErr *CircuitOpenError
+	if _, err := tr.RoundTrip(req); !errors.As(err, &openErr) {
+		t.Fatalf("RoundTrip() error = %v, want CircuitOpenError", err)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("calls = %d, want 2 before the circuit opened", calls.Load())
+	}
+	if _, err := tr.RoundTrip(req); !errors.As(err, &openErr) || calls.Load() != 2 {
+		t.Fatalf("RoundTrip() error = %v, calls = %d; want fail fast", err, calls.Load())
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
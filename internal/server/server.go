@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Options は HTTP サーバーのタイムアウトと終了時の猶予期間です。
+type Options struct {
+	Addr              string
+	ReadHeaderTimeout time.Duration
+	ReadTimeout       time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+	// ShutdownGracePeriod は終了シグナルの受信後、処理中のリクエストの完了を待つ時間です。
+	// Cloud Run は SIGTERM の 10 秒後に SIGKILL を送るため、それより短くしてください。
+	ShutdownGracePeriod time.Duration
+}
+
+// DefaultOptions は Cloud Run 上で動かす場合の既定値です。
+// WriteTimeout は通知の一括配信など時間のかかる操作が打ち切られないよう長めにしています。
+func DefaultOptions() Options {
+	return Options{
+		Addr:                ":8080",
+		ReadHeaderTimeout:   10 * time.Second,
+		ReadTimeout:         30 * time.Second,
+		WriteTimeout:        120 * time.Second,
+		IdleTimeout:         120 * time.Second,
+		ShutdownGracePeriod: 8 * time.Second,
+	}
+}
+
+// Run は opts.Addr で待ち受け、ctx が終了するまで handler を提供します。
+func Run(ctx context.Context, handler http.Handler, opts Options) error {
+	listener, err := net.Listen("tcp", opts.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", opts.Addr, err)
+	}
+	return Serve(ctx, listener, handler, opts)
+}
+
+// Serve は listener で handler を提供します。
+// ctx が終了すると新しい接続の受け付けを止め、処理中のリクエストを ShutdownGracePeriod まで待ってから戻ります。
+// 猶予期間内に完了しなかった場合は残りの接続を閉じて context.DeadlineExceeded を返します。
+func Serve(ctx context.Context, listener net.Listener, handler http.Handler, opts Options) error {
+	srv := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: opts.ReadHeaderTimeout,
+		ReadTimeout:       opts.ReadTimeout,
+		WriteTimeout:      opts.WriteTimeout,
+		IdleTimeout:       opts.IdleTimeout,
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		slog.Info("Server starting", slog.String("addr", listener.Addr().String()))
+		serveErr <- srv.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
+
+	slog.Info("Shutting down server", slog.Duration("gracePeriod", opts.ShutdownGracePeriod))
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), opts.ShutdownGracePeriod)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		_ = srv.Close()
+		return fmt.Errorf("failed to drain in-flight requests: %w", err)
+	}
+	if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	slog.Info("Server stopped")
+	return nil
+}
@@ -0,0 +1,99 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// ServiceName はトレースに付与するサービス名です。
+const ServiceName = "admin-bff-api"
+
+// TracerName は BFF 自身が作成するスパンの計装名です。
+const TracerName = "github.com/fun-dotto/admin-bff-api"
+
+// TraceExporter はトレースの出力先です。
+type TraceExporter string
+
+const (
+	// TraceExporterNone はトレースを出力しません。
+	TraceExporterNone TraceExporter = "none"
+	// TraceExporterStdout はトレースを標準出力に出力します。
+	TraceExporterStdout TraceExporter = "stdout"
+	// TraceExporterOTLP はトレースを OTLP/HTTP で送信します。
+	// 送信先は OTEL_EXPORTER_OTLP_ENDPOINT などの標準の環境変数で指定します。
+	TraceExporterOTLP TraceExporter = "otlp"
+)
+
+// ParseTraceExporter は文字列を TraceExporter に変換します。空文字列は none として扱います。
+func ParseTraceExporter(s string) (TraceExporter, error) {
+	switch exporter := TraceExporter(strings.ToLower(strings.TrimSpace(s))); exporter {
+	case "":
+		return TraceExporterNone, nil
+	case TraceExporterNone, TraceExporterStdout, TraceExporterOTLP:
+		return exporter, nil
+	default:
+		return "", fmt.Errorf("unknown trace exporter %q", s)
+	}
+}
+
+// SetupTracing は exporter に応じた TracerProvider と W3C Trace Context の伝播をグローバルに設定します。
+// 返された関数でバッファ済みのスパンを送信して TracerProvider を終了してください。
+func SetupTracing(ctx context.Context, exporter TraceExporter) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if exporter == TraceExporterNone {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	spanExporter, err := newSpanExporter(ctx, exporter)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+func newSpanExporter(ctx context.Context, exporter TraceExporter) (sdktrace.SpanExporter, error) {
+	switch exporter {
+	case TraceExporterStdout:
+		spanExporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout trace exporter: %w", err)
+		}
+		return spanExporter, nil
+	case TraceExporterOTLP:
+		spanExporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+		}
+		return spanExporter, nil
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", exporter)
+	}
+}
@@ -0,0 +1,9 @@
+// Package openapi は BFF の OpenAPI 仕様をバイナリに埋め込みます。
+package openapi
+
+import _ "embed"
+
+// Spec は openapi.yaml の内容です。
+//
+//go:embed openapi.yaml
+var Spec []byte